@@ -19,6 +19,21 @@ const (
 
 type AudioStream struct {
 	handle *C.SDL_AudioStream
+
+	// IDs in the callbacks registry, 0 if unset
+	getCallback uintptr
+	putCallback uintptr
+
+	// The application's put callback and the channel readers wait on; both
+	// feed the single put callback installed in SDL.
+	onPut    AudioStreamCallback
+	readWake chan struct{}
+
+	// Trailing partial frame from Write
+	pending []byte
+
+	// Set for streams from OpenAudioStream, whose device closes with them
+	ownsDevice bool
 }
 
 type AudioDevice struct {
@@ -27,23 +42,81 @@ type AudioDevice struct {
 }
 
 func OpenPlaybackStream(sampleRate, channels int) (*AudioStream, error) {
-	return openAudioStream(AUDIO_DEVICE_DEFAULT_PLAYBACK, sampleRate, channels)
+	return OpenAudioStream(AUDIO_DEVICE_DEFAULT_PLAYBACK, AudioSpec{Format: AUDIO_F32, Channels: channels, Freq: sampleRate})
 }
 
 func OpenRecordingStream(sampleRate, channels int) (*AudioStream, error) {
-	return openAudioStream(AUDIO_DEVICE_DEFAULT_RECORDING, sampleRate, channels)
+	return OpenAudioStream(AUDIO_DEVICE_DEFAULT_RECORDING, AudioSpec{Format: AUDIO_F32, Channels: channels, Freq: sampleRate})
+}
+
+// OpenPlaybackStreamSpec opens the default playback device. Data put into the
+// stream must be in spec; SDL converts it to the device format.
+func OpenPlaybackStreamSpec(spec AudioSpec) (*AudioStream, error) {
+	return OpenAudioStream(AUDIO_DEVICE_DEFAULT_PLAYBACK, spec)
+}
+
+// OpenRecordingStreamSpec opens the default recording device. Data read from
+// the stream is converted to spec.
+func OpenRecordingStreamSpec(spec AudioSpec) (*AudioStream, error) {
+	return OpenAudioStream(AUDIO_DEVICE_DEFAULT_RECORDING, spec)
 }
 
-func openAudioStream(device AudioDeviceID, sampleRate, channels int) (*AudioStream, error) {
-	if sampleRate <= 0 || channels < 1 || channels > 8 {
-		return nil, fmt.Errorf("invalid audio format %d Hz, %d channels", sampleRate, channels)
+// OpenAudioStream opens device, one of the IDs from PlaybackDevices or
+// RecordingDevices or a default device constant, and returns a stream bound
+// to it in spec. The device starts paused; call Resume to start it.
+// Destroying the stream closes the device.
+func OpenAudioStream(device AudioDeviceID, spec AudioSpec) (*AudioStream, error) {
+	if !spec.valid() {
+		return nil, fmt.Errorf("invalid audio format %v, %d Hz, %d channels", spec.Format, spec.Freq, spec.Channels)
 	}
-	spec := C.SDL_AudioSpec{format: C.SDL_AUDIO_F32, channels: C.int(channels), freq: C.int(sampleRate)}
-	handle := C.SDL_OpenAudioDeviceStream(C.SDL_AudioDeviceID(device), &spec, nil, nil)
+	cspec := spec.cspec()
+	handle := C.SDL_OpenAudioDeviceStream(C.SDL_AudioDeviceID(device), &cspec, nil, nil)
 	if handle == nil {
 		return nil, GetError()
 	}
-	return &AudioStream{handle: handle}, nil
+	return &AudioStream{handle: handle, ownsDevice: true}, nil
+}
+
+// OpenAudioDevice opens a new logical device on a physical device or a
+// default device constant. Several logical devices can share one physical
+// device, each with its own bound streams, gain and pause state. spec is a
+// format hint and may be nil to use the device's preferred format. The
+// device starts unpaused.
+func OpenAudioDevice(device AudioDeviceID, spec *AudioSpec) (AudioDeviceID, error) {
+	var cspec *C.SDL_AudioSpec
+	if spec != nil {
+		if !spec.valid() {
+			return 0, fmt.Errorf("invalid audio format %v, %d Hz, %d channels", spec.Format, spec.Freq, spec.Channels)
+		}
+		converted := spec.cspec()
+		cspec = &converted
+	}
+	id := C.SDL_OpenAudioDevice(C.SDL_AudioDeviceID(device), cspec)
+	if id == 0 {
+		return 0, GetError()
+	}
+	return AudioDeviceID(id), nil
+}
+
+// CloseAudioDevice closes a logical device from OpenAudioDevice and unbinds
+// its streams. The streams themselves stay valid.
+func CloseAudioDevice(device AudioDeviceID) {
+	C.SDL_CloseAudioDevice(C.SDL_AudioDeviceID(device))
+	releasePostmixCallback(device)
+}
+
+func PauseAudioDevice(device AudioDeviceID) error {
+	if !C.SDL_PauseAudioDevice(C.SDL_AudioDeviceID(device)) {
+		return GetError()
+	}
+	return nil
+}
+
+func ResumeAudioDevice(device AudioDeviceID) error {
+	if !C.SDL_ResumeAudioDevice(C.SDL_AudioDeviceID(device)) {
+		return GetError()
+	}
+	return nil
 }
 
 func PlaybackDevices() []AudioDevice {
@@ -54,6 +127,19 @@ func RecordingDevices() []AudioDevice {
 	return audioDevices(true)
 }
 
+// AudioDeviceFormat returns the current format of a device and the number of
+// sample frames it processes per callback. For the default device constants
+// it reports the format SDL would open with. Call it again after
+// EVENT_AUDIO_DEVICE_FORMAT_CHANGED.
+func AudioDeviceFormat(device AudioDeviceID) (AudioSpec, int, error) {
+	var spec C.SDL_AudioSpec
+	var sampleFrames C.int
+	if !C.SDL_GetAudioDeviceFormat(C.SDL_AudioDeviceID(device), &spec, &sampleFrames) {
+		return AudioSpec{}, 0, GetError()
+	}
+	return audioSpecFromC(spec), int(sampleFrames), nil
+}
+
 func audioDevices(recording bool) []AudioDevice {
 	var count C.int
 	var ids *C.SDL_AudioDeviceID
@@ -118,37 +204,142 @@ func (s *AudioStream) AvailableBytes() int {
 	return int(C.SDL_GetAudioStreamAvailable(s.handle))
 }
 
-func (s *AudioStream) PutFloat32(samples []float32) error {
+// AudioSample is a Go type that can hold one sample of an AudioFormat:
+// uint8 for AUDIO_U8, int16 for AUDIO_S16, int32 for AUDIO_S32 and float32
+// for AUDIO_F32.
+type AudioSample interface {
+	uint8 | int16 | int32 | float32
+}
+
+// PutSamples queues interleaved samples. T must match the stream's input
+// format in size and kind; the byte order is always native.
+func PutSamples[T AudioSample](s *AudioStream, samples []T) error {
 	if s == nil || s.handle == nil {
 		return fmt.Errorf("audio stream is closed")
 	}
 	if len(samples) == 0 {
 		return nil
 	}
-	if !C.SDL_PutAudioStreamData(s.handle, unsafe.Pointer(&samples[0]), C.int(len(samples)*4)) {
-		return GetError()
+	input, _, err := s.Format()
+	if err != nil {
+		return err
+	}
+	if err := checkSampleType[T](input.Format); err != nil {
+		return err
+	}
+	return s.PutBytes(unsafe.Slice((*byte)(unsafe.Pointer(&samples[0])), len(samples)*int(unsafe.Sizeof(samples[0]))))
+}
+
+// GetSamples reads converted samples into samples and returns how many were
+// read. T must match the stream's output format.
+func GetSamples[T AudioSample](s *AudioStream, samples []T) (int, error) {
+	if s == nil || s.handle == nil {
+		return 0, fmt.Errorf("audio stream is closed")
+	}
+	if len(samples) == 0 {
+		return 0, nil
+	}
+	_, output, err := s.Format()
+	if err != nil {
+		return 0, err
+	}
+	if err := checkSampleType[T](output.Format); err != nil {
+		return 0, err
+	}
+	size := int(unsafe.Sizeof(samples[0]))
+	got, err := s.GetBytes(unsafe.Slice((*byte)(unsafe.Pointer(&samples[0])), len(samples)*size))
+	return got / size, err
+}
+
+func checkSampleType[T AudioSample](format AudioFormat) error {
+	var zero T
+	var float bool
+	switch any(zero).(type) {
+	case float32:
+		float = true
+	}
+	if format.ByteSize() != int(unsafe.Sizeof(zero)) || format.IsFloat() != float {
+		return fmt.Errorf("%T samples do not match stream format %v", zero, format)
+	}
+	if format.ByteSize() > 1 && format.IsBigEndian() != nativeBigEndian {
+		return fmt.Errorf("stream format %v is not in native byte order", format)
 	}
 	return nil
 }
 
+var nativeBigEndian = AUDIO_S16 == AUDIO_S16BE
+
+func (s *AudioStream) PutFloat32(samples []float32) error {
+	return PutSamples(s, samples)
+}
+
 func (s *AudioStream) GetFloat32(samples []float32) (int, error) {
+	return GetSamples(s, samples)
+}
+
+func (s *AudioStream) PutInt16(samples []int16) error {
+	return PutSamples(s, samples)
+}
+
+func (s *AudioStream) GetInt16(samples []int16) (int, error) {
+	return GetSamples(s, samples)
+}
+
+// PutBytes queues raw sample data in the stream's input format. len(data)
+// should be a multiple of the frame size.
+func (s *AudioStream) PutBytes(data []byte) error {
+	if s == nil || s.handle == nil {
+		return fmt.Errorf("audio stream is closed")
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	if !C.SDL_PutAudioStreamData(s.handle, unsafe.Pointer(&data[0]), C.int(len(data))) {
+		return GetError()
+	}
+	return nil
+}
+
+// GetBytes reads raw converted data in the stream's output format and returns
+// the number of bytes read, which is always a whole number of frames.
+func (s *AudioStream) GetBytes(data []byte) (int, error) {
 	if s == nil || s.handle == nil {
 		return 0, fmt.Errorf("audio stream is closed")
 	}
-	if len(samples) == 0 {
+	if len(data) == 0 {
 		return 0, nil
 	}
-	got := int(C.SDL_GetAudioStreamData(s.handle, unsafe.Pointer(&samples[0]), C.int(len(samples)*4)))
+	got := int(C.SDL_GetAudioStreamData(s.handle, unsafe.Pointer(&data[0]), C.int(len(data))))
 	if got < 0 {
 		return 0, GetError()
 	}
-	return got / 4, nil
+	return got, nil
+}
+
+// Format returns the format of data going into the stream and coming out of
+// it. For a stream opened on a device, the device side reflects the device's
+// current format.
+func (s *AudioStream) Format() (input, output AudioSpec, err error) {
+	if s == nil || s.handle == nil {
+		return input, output, fmt.Errorf("audio stream is closed")
+	}
+	var src, dst C.SDL_AudioSpec
+	if !C.SDL_GetAudioStreamFormat(s.handle, &src, &dst) {
+		return input, output, GetError()
+	}
+	return audioSpecFromC(src), audioSpecFromC(dst), nil
 }
 
 func (s *AudioStream) Destroy() {
 	if s == nil || s.handle == nil {
 		return
 	}
+	device := s.Device()
 	C.SDL_DestroyAudioStream(s.handle)
+	if s.ownsDevice {
+		releasePostmixCallback(device)
+	}
 	s.handle = nil
+	s.pending = nil
+	s.releaseCallbacks()
 }
@@ -0,0 +1,108 @@
+package sdl3go
+
+/*
+#include <stdint.h>
+#include <SDL3/SDL.h>
+
+extern void sdl3goAudioStreamCallback(void *userdata, SDL_AudioStream *stream, int additional_amount, int total_amount);
+
+static bool sdl3go_set_audio_stream_get_callback(SDL_AudioStream *stream, uintptr_t id) {
+    return SDL_SetAudioStreamGetCallback(stream, id ? sdl3goAudioStreamCallback : NULL, (void *)id);
+}
+static bool sdl3go_set_audio_stream_put_callback(SDL_AudioStream *stream, uintptr_t id) {
+    return SDL_SetAudioStreamPutCallback(stream, id ? sdl3goAudioStreamCallback : NULL, (void *)id);
+}
+*/
+import "C"
+
+import "fmt"
+
+// AudioStreamCallback is called on SDL's audio thread with the stream locked.
+// For a get callback, additionalAmount is the number of bytes SDL needs right
+// now and totalAmount what it is about to read in all; for a put callback,
+// additionalAmount is the number of bytes just queued and totalAmount the
+// total now available. Callbacks must not block: generate or consume data
+// with the stream's Put and Get methods and return.
+type AudioStreamCallback func(stream *AudioStream, additionalAmount, totalAmount int)
+
+type audioStreamCallbackEntry struct {
+	stream   *AudioStream
+	callback AudioStreamCallback
+}
+
+// SetGetCallback installs callback to run whenever data is about to be read
+// from the stream, e.g. when a playback device needs more audio. This lets
+// synthesizers render on demand instead of queueing ahead. Pass nil to
+// remove it.
+func (s *AudioStream) SetGetCallback(callback AudioStreamCallback) error {
+	if s == nil || s.handle == nil {
+		return fmt.Errorf("audio stream is closed")
+	}
+	id := s.registerCallback(callback)
+	if !C.sdl3go_set_audio_stream_get_callback(s.handle, C.uintptr_t(id)) {
+		callbacks.remove(id)
+		return GetError()
+	}
+	// SDL holds the stream lock while swapping callbacks, so the old one can
+	// no longer be running.
+	callbacks.remove(s.getCallback)
+	s.getCallback = id
+	return nil
+}
+
+// SetPutCallback installs callback to run after data is put into the stream,
+// e.g. when a recording device delivers new audio. Pass nil to remove it.
+func (s *AudioStream) SetPutCallback(callback AudioStreamCallback) error {
+	if s == nil || s.handle == nil {
+		return fmt.Errorf("audio stream is closed")
+	}
+	previous := s.onPut
+	s.onPut = callback
+	if err := s.installPutCallback(); err != nil {
+		s.onPut = previous
+		return err
+	}
+	return nil
+}
+
+// installPutCallback gives SDL one callback that runs the application's put
+// callback, if any, and then wakes a blocked AudioStreamReader.
+func (s *AudioStream) installPutCallback() error {
+	callback, wake := s.onPut, s.readWake
+	if wake != nil {
+		onPut := callback
+		callback = func(stream *AudioStream, additionalAmount, totalAmount int) {
+			if onPut != nil {
+				onPut(stream, additionalAmount, totalAmount)
+			}
+			select {
+			case wake <- struct{}{}:
+			default:
+			}
+		}
+	}
+	id := s.registerCallback(callback)
+	if !C.sdl3go_set_audio_stream_put_callback(s.handle, C.uintptr_t(id)) {
+		callbacks.remove(id)
+		return GetError()
+	}
+	callbacks.remove(s.putCallback)
+	s.putCallback = id
+	return nil
+}
+
+func (s *AudioStream) registerCallback(callback AudioStreamCallback) uintptr {
+	if callback == nil {
+		return 0
+	}
+	return callbacks.add(audioStreamCallbackEntry{stream: s, callback: callback})
+}
+
+// releaseCallbacks forgets the stream's callbacks once SDL can no longer
+// call them.
+func (s *AudioStream) releaseCallbacks() {
+	callbacks.remove(s.getCallback)
+	callbacks.remove(s.putCallback)
+	s.getCallback, s.putCallback = 0, 0
+	s.onPut, s.readWake = nil, nil
+}
@@ -0,0 +1,210 @@
+package sdl3go
+
+/*
+#include <SDL3/SDL.h>
+*/
+import "C"
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// Channel names one speaker position.
+type Channel int
+
+const (
+	CHANNEL_FRONT_LEFT Channel = iota
+	CHANNEL_FRONT_RIGHT
+	CHANNEL_FRONT_CENTER
+	CHANNEL_LFE
+	CHANNEL_BACK_LEFT
+	CHANNEL_BACK_RIGHT
+	CHANNEL_BACK_CENTER
+	CHANNEL_SIDE_LEFT
+	CHANNEL_SIDE_RIGHT
+)
+
+var channelNames = [...]string{"FL", "FR", "FC", "LFE", "BL", "BR", "BC", "SL", "SR"}
+
+func (c Channel) String() string {
+	if c < 0 || int(c) >= len(channelNames) {
+		return fmt.Sprintf("Channel(%d)", int(c))
+	}
+	return channelNames[c]
+}
+
+// ChannelLayout is one of the speaker arrangements SDL uses for a given
+// channel count. Interleaved data in SDL is always in the order returned by
+// Channels.
+type ChannelLayout int
+
+const (
+	LAYOUT_MONO ChannelLayout = iota + 1
+	LAYOUT_STEREO
+	LAYOUT_2_1
+	LAYOUT_QUAD
+	LAYOUT_4_1
+	LAYOUT_5_1
+	LAYOUT_6_1
+	LAYOUT_7_1
+)
+
+var channelLayouts = [...][]Channel{
+	LAYOUT_MONO:   {CHANNEL_FRONT_CENTER},
+	LAYOUT_STEREO: {CHANNEL_FRONT_LEFT, CHANNEL_FRONT_RIGHT},
+	LAYOUT_2_1:    {CHANNEL_FRONT_LEFT, CHANNEL_FRONT_RIGHT, CHANNEL_LFE},
+	LAYOUT_QUAD:   {CHANNEL_FRONT_LEFT, CHANNEL_FRONT_RIGHT, CHANNEL_BACK_LEFT, CHANNEL_BACK_RIGHT},
+	LAYOUT_4_1:    {CHANNEL_FRONT_LEFT, CHANNEL_FRONT_RIGHT, CHANNEL_LFE, CHANNEL_BACK_LEFT, CHANNEL_BACK_RIGHT},
+	LAYOUT_5_1:    {CHANNEL_FRONT_LEFT, CHANNEL_FRONT_RIGHT, CHANNEL_FRONT_CENTER, CHANNEL_LFE, CHANNEL_BACK_LEFT, CHANNEL_BACK_RIGHT},
+	LAYOUT_6_1:    {CHANNEL_FRONT_LEFT, CHANNEL_FRONT_RIGHT, CHANNEL_FRONT_CENTER, CHANNEL_LFE, CHANNEL_BACK_CENTER, CHANNEL_SIDE_LEFT, CHANNEL_SIDE_RIGHT},
+	LAYOUT_7_1:    {CHANNEL_FRONT_LEFT, CHANNEL_FRONT_RIGHT, CHANNEL_FRONT_CENTER, CHANNEL_LFE, CHANNEL_BACK_LEFT, CHANNEL_BACK_RIGHT, CHANNEL_SIDE_LEFT, CHANNEL_SIDE_RIGHT},
+}
+
+// LayoutForChannels returns the layout SDL assumes for an AudioSpec with
+// that many channels.
+func LayoutForChannels(channels int) (ChannelLayout, error) {
+	if channels < int(LAYOUT_MONO) || channels > int(LAYOUT_7_1) {
+		return 0, fmt.Errorf("no channel layout for %d channels", channels)
+	}
+	return ChannelLayout(channels), nil
+}
+
+// Count is the number of channels in the layout, matching AudioSpec.Channels.
+func (l ChannelLayout) Count() int {
+	return len(l.Channels())
+}
+
+// Channels returns the speaker of each interleaved channel, in SDL order.
+func (l ChannelLayout) Channels() []Channel {
+	if l < LAYOUT_MONO || l > LAYOUT_7_1 {
+		return nil
+	}
+	return append([]Channel(nil), channelLayouts[l]...)
+}
+
+func (l ChannelLayout) String() string {
+	switch l {
+	case LAYOUT_MONO:
+		return "mono"
+	case LAYOUT_STEREO:
+		return "stereo"
+	case LAYOUT_2_1:
+		return "2.1"
+	case LAYOUT_QUAD:
+		return "quad"
+	case LAYOUT_4_1:
+		return "4.1"
+	case LAYOUT_5_1:
+		return "5.1"
+	case LAYOUT_6_1:
+		return "6.1"
+	case LAYOUT_7_1:
+		return "7.1"
+	}
+	return fmt.Sprintf("ChannelLayout(%d)", int(l))
+}
+
+// ChannelMapFrom builds a channel map for data whose channels are in order
+// rather than SDL's order for this layout, such as film-order 5.1
+// (FL, FC, FR, BL, BR, LFE). Pass the result to SetInputChannelMap. Speakers
+// in the layout that order lacks are muted.
+func (l ChannelLayout) ChannelMapFrom(order []Channel) ([]int, error) {
+	channels := l.Channels()
+	if channels == nil {
+		return nil, fmt.Errorf("unknown channel layout %v", l)
+	}
+	if len(order) != len(channels) {
+		return nil, fmt.Errorf("%v needs %d channels, got %d", l, len(channels), len(order))
+	}
+	chmap := make([]int, len(channels))
+	for i, want := range channels {
+		chmap[i] = -1
+		for j, have := range order {
+			if have == want {
+				chmap[i] = j
+				break
+			}
+		}
+	}
+	return chmap, nil
+}
+
+// SetInputChannelMap reorders data added with Put. chmap[i] is the index of
+// the input channel that feeds channel i, or -1 to silence it, so {1, 0}
+// swaps left and right. Its length must equal the input channel count. A nil
+// map restores the default order.
+func (s *AudioStream) SetInputChannelMap(chmap []int) error {
+	if s == nil || s.handle == nil {
+		return fmt.Errorf("audio stream is closed")
+	}
+	cmap := cChannelMap(chmap)
+	if !C.SDL_SetAudioStreamInputChannelMap(s.handle, cmap.ptr(), C.int(len(cmap))) {
+		return GetError()
+	}
+	return nil
+}
+
+// SetOutputChannelMap reorders data returned by Get, using the same form as
+// SetInputChannelMap. Its length must equal the output channel count.
+func (s *AudioStream) SetOutputChannelMap(chmap []int) error {
+	if s == nil || s.handle == nil {
+		return fmt.Errorf("audio stream is closed")
+	}
+	cmap := cChannelMap(chmap)
+	if !C.SDL_SetAudioStreamOutputChannelMap(s.handle, cmap.ptr(), C.int(len(cmap))) {
+		return GetError()
+	}
+	return nil
+}
+
+// InputChannelMap returns nil when the stream uses the default order.
+func (s *AudioStream) InputChannelMap() []int {
+	if s == nil || s.handle == nil {
+		return nil
+	}
+	var count C.int
+	return goChannelMap(C.SDL_GetAudioStreamInputChannelMap(s.handle, &count), count)
+}
+
+// OutputChannelMap returns nil when the stream uses the default order.
+func (s *AudioStream) OutputChannelMap() []int {
+	if s == nil || s.handle == nil {
+		return nil
+	}
+	var count C.int
+	return goChannelMap(C.SDL_GetAudioStreamOutputChannelMap(s.handle, &count), count)
+}
+
+type channelMap []C.int
+
+func cChannelMap(chmap []int) channelMap {
+	if chmap == nil {
+		return nil
+	}
+	cmap := make(channelMap, len(chmap))
+	for i, ch := range chmap {
+		cmap[i] = C.int(ch)
+	}
+	return cmap
+}
+
+func (m channelMap) ptr() *C.int {
+	if len(m) == 0 {
+		return nil
+	}
+	return &m[0]
+}
+
+func goChannelMap(cmap *C.int, count C.int) []int {
+	if cmap == nil {
+		return nil
+	}
+	defer C.SDL_free(unsafe.Pointer(cmap))
+	src := unsafe.Slice(cmap, int(count))
+	chmap := make([]int, len(src))
+	for i, ch := range src {
+		chmap[i] = int(ch)
+	}
+	return chmap
+}
@@ -0,0 +1,71 @@
+package sdl3go
+
+/*
+#include <SDL3/SDL.h>
+*/
+import "C"
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// ConvertAudioSamples converts a complete buffer of audio from src to dst,
+// resampling if the rates differ. It opens no device. For audio that arrives
+// in pieces, use a NewAudioStream instead so that resampling state carries
+// over between calls.
+func ConvertAudioSamples(src AudioSpec, data []byte, dst AudioSpec) ([]byte, error) {
+	if !src.valid() {
+		return nil, fmt.Errorf("invalid source format %v, %d Hz, %d channels", src.Format, src.Freq, src.Channels)
+	}
+	if !dst.valid() {
+		return nil, fmt.Errorf("invalid destination format %v, %d Hz, %d channels", dst.Format, dst.Freq, dst.Channels)
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+	csrc, cdst := src.cspec(), dst.cspec()
+	var out *C.Uint8
+	var outLen C.int
+	if !C.SDL_ConvertAudioSamples(&csrc, (*C.Uint8)(unsafe.Pointer(&data[0])), C.int(len(data)), &cdst, &out, &outLen) {
+		return nil, GetError()
+	}
+	defer C.SDL_free(unsafe.Pointer(out))
+	return C.GoBytes(unsafe.Pointer(out), outLen), nil
+}
+
+// Flush tells the stream no more input is coming, so the data held back for
+// resampling becomes available to Get. Put more data afterwards to start a
+// new segment. Unbound streams used as converters need this at the end of
+// their input; device streams usually do not.
+func (s *AudioStream) Flush() error {
+	if s == nil || s.handle == nil {
+		return fmt.Errorf("audio stream is closed")
+	}
+	if !C.SDL_FlushAudioStream(s.handle) {
+		return GetError()
+	}
+	return nil
+}
+
+// SetFormat changes the input format, output format, or both. A nil spec
+// leaves that side unchanged. The device side of a bound stream cannot be
+// changed.
+func (s *AudioStream) SetFormat(input, output *AudioSpec) error {
+	if s == nil || s.handle == nil {
+		return fmt.Errorf("audio stream is closed")
+	}
+	var csrc, cdst *C.SDL_AudioSpec
+	if input != nil {
+		converted := input.cspec()
+		csrc = &converted
+	}
+	if output != nil {
+		converted := output.cspec()
+		cdst = &converted
+	}
+	if !C.SDL_SetAudioStreamFormat(s.handle, csrc, cdst) {
+		return GetError()
+	}
+	return nil
+}
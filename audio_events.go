@@ -0,0 +1,47 @@
+// audio_events.go
+package sdl3go
+
+/*
+#include <SDL3/SDL.h>
+
+static inline Uint32 get_event_type(SDL_Event *event) {
+    return event->type;
+}
+
+static inline Uint64 get_adevice_timestamp(SDL_Event *event) {
+    return event->adevice.timestamp;
+}
+
+static inline SDL_AudioDeviceID get_adevice_which(SDL_Event *event) {
+    return event->adevice.which;
+}
+
+static inline bool get_adevice_recording(SDL_Event *event) {
+    return event->adevice.recording;
+}
+*/
+import "C"
+
+// AudioDeviceEvent - Audio device added, removed or changed format
+//
+// When the default device changes, SDL moves streams opened on
+// AUDIO_DEVICE_DEFAULT_PLAYBACK or AUDIO_DEVICE_DEFAULT_RECORDING by itself.
+// Streams opened on a specific device stop when it is removed; on
+// EVENT_AUDIO_DEVICE_REMOVED for their device, reopen them on a default
+// device or rebind them with BindAudioStream.
+type AudioDeviceEvent struct {
+	Type      EventType
+	Timestamp uint64        // In nanoseconds
+	Which     AudioDeviceID // The physical device; for REMOVED also any logical device opened on it
+	Recording bool          // True for a recording device, false for playback
+}
+
+// Internal parser for events.go
+func parseAudioDeviceEvent(cevent *C.SDL_Event) *AudioDeviceEvent {
+	return &AudioDeviceEvent{
+		Type:      EventType(C.get_event_type(cevent)),
+		Timestamp: uint64(C.get_adevice_timestamp(cevent)),
+		Which:     AudioDeviceID(C.get_adevice_which(cevent)),
+		Recording: bool(C.get_adevice_recording(cevent)),
+	}
+}
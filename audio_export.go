@@ -0,0 +1,29 @@
+package sdl3go
+
+/*
+#include <SDL3/SDL.h>
+*/
+import "C"
+
+import "unsafe"
+
+// Trampolines called by SDL on its audio thread. userdata is an ID in the
+// callbacks registry, never a real pointer.
+
+//export sdl3goAudioStreamCallback
+func sdl3goAudioStreamCallback(userdata unsafe.Pointer, stream *C.SDL_AudioStream, additionalAmount, totalAmount C.int) {
+	entry, ok := callbacks.get(uintptr(userdata)).(audioStreamCallbackEntry)
+	if !ok {
+		return
+	}
+	entry.callback(entry.stream, int(additionalAmount), int(totalAmount))
+}
+
+//export sdl3goAudioPostmixCallback
+func sdl3goAudioPostmixCallback(userdata unsafe.Pointer, spec *C.SDL_AudioSpec, buffer *C.float, buflen C.int) {
+	callback, ok := callbacks.get(uintptr(userdata)).(AudioPostmixCallback)
+	if !ok || buffer == nil || buflen <= 0 {
+		return
+	}
+	callback(audioSpecFromC(*spec), unsafe.Slice((*float32)(unsafe.Pointer(buffer)), int(buflen)/4))
+}
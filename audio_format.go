@@ -0,0 +1,80 @@
+package sdl3go
+
+/*
+#include <SDL3/SDL.h>
+*/
+import "C"
+
+import "strings"
+
+// AudioFormat describes the type of one audio sample (SDL_AudioFormat).
+type AudioFormat uint32
+
+const (
+	AUDIO_UNKNOWN AudioFormat = C.SDL_AUDIO_UNKNOWN
+	AUDIO_U8      AudioFormat = C.SDL_AUDIO_U8
+	AUDIO_S8      AudioFormat = C.SDL_AUDIO_S8
+	AUDIO_S16LE   AudioFormat = C.SDL_AUDIO_S16LE
+	AUDIO_S16BE   AudioFormat = C.SDL_AUDIO_S16BE
+	AUDIO_S32LE   AudioFormat = C.SDL_AUDIO_S32LE
+	AUDIO_S32BE   AudioFormat = C.SDL_AUDIO_S32BE
+	AUDIO_F32LE   AudioFormat = C.SDL_AUDIO_F32LE
+	AUDIO_F32BE   AudioFormat = C.SDL_AUDIO_F32BE
+
+	// Native byte order
+	AUDIO_S16 AudioFormat = C.SDL_AUDIO_S16
+	AUDIO_S32 AudioFormat = C.SDL_AUDIO_S32
+	AUDIO_F32 AudioFormat = C.SDL_AUDIO_F32
+)
+
+// String returns the constant name without the package prefix, e.g.
+// "AUDIO_F32LE".
+func (f AudioFormat) String() string {
+	name := C.GoString(C.SDL_GetAudioFormatName(C.SDL_AudioFormat(f)))
+	return strings.TrimPrefix(name, "SDL_")
+}
+
+func (f AudioFormat) BitSize() int {
+	return int(uint32(f) & C.SDL_AUDIO_MASK_BITSIZE)
+}
+
+// ByteSize is the size of one sample of one channel.
+func (f AudioFormat) ByteSize() int {
+	return f.BitSize() / 8
+}
+
+func (f AudioFormat) IsFloat() bool {
+	return uint32(f)&C.SDL_AUDIO_MASK_FLOAT != 0
+}
+
+func (f AudioFormat) IsSigned() bool {
+	return uint32(f)&C.SDL_AUDIO_MASK_SIGNED != 0
+}
+
+func (f AudioFormat) IsBigEndian() bool {
+	return uint32(f)&C.SDL_AUDIO_MASK_BIG_ENDIAN != 0
+}
+
+// AudioSpec mirrors SDL_AudioSpec. Channels are interleaved.
+type AudioSpec struct {
+	Format   AudioFormat
+	Channels int
+	Freq     int // Sample frames per second
+}
+
+// FrameSize is the size in bytes of one sample for every channel.
+func (spec AudioSpec) FrameSize() int {
+	return spec.Format.ByteSize() * spec.Channels
+}
+
+func (spec AudioSpec) valid() bool {
+	return spec.Freq > 0 && spec.Channels >= 1 && spec.Channels <= 8 && spec.Format.ByteSize() > 0
+}
+
+func (spec AudioSpec) cspec() C.SDL_AudioSpec {
+	return C.SDL_AudioSpec{format: C.SDL_AudioFormat(spec.Format), channels: C.int(spec.Channels), freq: C.int(spec.Freq)}
+}
+
+func audioSpecFromC(spec C.SDL_AudioSpec) AudioSpec {
+	return AudioSpec{Format: AudioFormat(spec.format), Channels: int(spec.channels), Freq: int(spec.freq)}
+}
@@ -0,0 +1,103 @@
+package sdl3go
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// Write queues interleaved bytes in the stream's input format, so a stream
+// can be the destination of io.Copy. Writes need not be frame aligned: a
+// trailing partial frame is held until the next Write completes it.
+func (s *AudioStream) Write(p []byte) (int, error) {
+	if s == nil || s.handle == nil {
+		return 0, fmt.Errorf("audio stream is closed")
+	}
+	input, _, err := s.Format()
+	if err != nil {
+		return 0, err
+	}
+	frameSize := input.FrameSize()
+	data := p
+	if len(s.pending) > 0 {
+		data = append(s.pending, p...)
+	}
+	whole := len(data) - len(data)%frameSize
+	if err := s.PutBytes(data[:whole]); err != nil {
+		return 0, err
+	}
+	s.pending = append(s.pending[:0], data[whole:]...)
+	return len(p), nil
+}
+
+// AudioStreamReader reads a stream's output through io.Reader, for example
+// to io.Copy a recording stream into an encoder or network connection. Like
+// the stream's other methods it is not safe for concurrent use: Destroy the
+// stream only after Read has returned.
+type AudioStreamReader struct {
+	stream *AudioStream
+	wake   chan struct{}
+
+	// Timeout bounds how long Read waits for at least one frame. Zero or
+	// less waits indefinitely.
+	Timeout time.Duration
+}
+
+// Reader returns an io.Reader over the stream's output in its output format.
+// Read sleeps until SDL puts new data into the stream, so the stream's put
+// callback is shared with the reader; SetPutCallback still works alongside
+// it. All readers of a stream share one wakeup, so use one at a time.
+func (s *AudioStream) Reader(timeout time.Duration) (*AudioStreamReader, error) {
+	if s == nil || s.handle == nil {
+		return nil, fmt.Errorf("audio stream is closed")
+	}
+	if s.readWake == nil {
+		s.readWake = make(chan struct{}, 1)
+		if err := s.installPutCallback(); err != nil {
+			s.readWake = nil
+			return nil, err
+		}
+	}
+	return &AudioStreamReader{stream: s, wake: s.readWake, Timeout: timeout}, nil
+}
+
+// Read blocks until at least one whole frame is available, then reads as
+// many whole frames as fit in p. It returns os.ErrDeadlineExceeded if the
+// timeout passes with nothing to read, io.ErrShortBuffer if p cannot hold a
+// single frame, and io.EOF if the stream has been destroyed.
+func (r *AudioStreamReader) Read(p []byte) (int, error) {
+	s := r.stream
+	if s == nil || s.handle == nil {
+		return 0, io.EOF
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+	_, output, err := s.Format()
+	if err != nil {
+		return 0, err
+	}
+	if len(p) < output.FrameSize() {
+		return 0, io.ErrShortBuffer
+	}
+	var timeout <-chan time.Time
+	if r.Timeout > 0 {
+		timer := time.NewTimer(r.Timeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	for {
+		// The wakeup is buffered, so data put between GetBytes and the
+		// select below is not missed.
+		n, err := s.GetBytes(p)
+		if n > 0 || err != nil {
+			return n, err
+		}
+		select {
+		case <-r.wake:
+		case <-timeout:
+			return 0, os.ErrDeadlineExceeded
+		}
+	}
+}
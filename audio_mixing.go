@@ -0,0 +1,151 @@
+package sdl3go
+
+/*
+#include <SDL3/SDL.h>
+*/
+import "C"
+
+import "fmt"
+
+// NewAudioStream creates a stream that is not bound to any device. Data put
+// in src comes out converted to dst. Bind it to a device with
+// BindAudioStream, or use it on its own as a converter: Put input, Flush at
+// the end, and Get the converted output, all without audio hardware.
+func NewAudioStream(src, dst AudioSpec) (*AudioStream, error) {
+	if !src.valid() {
+		return nil, fmt.Errorf("invalid source format %v, %d Hz, %d channels", src.Format, src.Freq, src.Channels)
+	}
+	if !dst.valid() {
+		return nil, fmt.Errorf("invalid destination format %v, %d Hz, %d channels", dst.Format, dst.Freq, dst.Channels)
+	}
+	csrc, cdst := src.cspec(), dst.cspec()
+	handle := C.SDL_CreateAudioStream(&csrc, &cdst)
+	if handle == nil {
+		return nil, GetError()
+	}
+	return &AudioStream{handle: handle}, nil
+}
+
+// BindAudioStream attaches stream to a logical device from OpenAudioDevice.
+// Every stream bound to a playback device is mixed into its output; a stream
+// bound to a recording device receives a copy of its input. A stream can be
+// bound to only one device at a time.
+func BindAudioStream(device AudioDeviceID, stream *AudioStream) error {
+	if stream == nil || stream.handle == nil {
+		return fmt.Errorf("audio stream is closed")
+	}
+	if !C.SDL_BindAudioStream(C.SDL_AudioDeviceID(device), stream.handle) {
+		return GetError()
+	}
+	return nil
+}
+
+// BindAudioStreams binds all streams at once, so they start playing in the
+// same device callback. Nothing is bound if any stream fails.
+func BindAudioStreams(device AudioDeviceID, streams []*AudioStream) error {
+	handles, err := audioStreamHandles(streams)
+	if err != nil || len(handles) == 0 {
+		return err
+	}
+	if !C.SDL_BindAudioStreams(C.SDL_AudioDeviceID(device), &handles[0], C.int(len(handles))) {
+		return GetError()
+	}
+	return nil
+}
+
+func UnbindAudioStream(stream *AudioStream) {
+	if stream == nil || stream.handle == nil {
+		return
+	}
+	C.SDL_UnbindAudioStream(stream.handle)
+}
+
+func UnbindAudioStreams(streams []*AudioStream) {
+	handles, err := audioStreamHandles(streams)
+	if err != nil || len(handles) == 0 {
+		return
+	}
+	C.SDL_UnbindAudioStreams(&handles[0], C.int(len(handles)))
+}
+
+func audioStreamHandles(streams []*AudioStream) ([]*C.SDL_AudioStream, error) {
+	handles := make([]*C.SDL_AudioStream, len(streams))
+	for i, stream := range streams {
+		if stream == nil || stream.handle == nil {
+			return nil, fmt.Errorf("audio stream %d is closed", i)
+		}
+		handles[i] = stream.handle
+	}
+	return handles, nil
+}
+
+// Device returns the logical device the stream is bound to, or 0.
+func (s *AudioStream) Device() AudioDeviceID {
+	if s == nil || s.handle == nil {
+		return 0
+	}
+	return AudioDeviceID(C.SDL_GetAudioStreamDevice(s.handle))
+}
+
+// SetGain scales the stream's output volume. 1.0 leaves it unchanged; the
+// change applies to data already queued.
+func (s *AudioStream) SetGain(gain float32) error {
+	if s == nil || s.handle == nil {
+		return fmt.Errorf("audio stream is closed")
+	}
+	if !C.SDL_SetAudioStreamGain(s.handle, C.float(gain)) {
+		return GetError()
+	}
+	return nil
+}
+
+func (s *AudioStream) Gain() (float32, error) {
+	if s == nil || s.handle == nil {
+		return 0, fmt.Errorf("audio stream is closed")
+	}
+	gain := float32(C.SDL_GetAudioStreamGain(s.handle))
+	if gain < 0 {
+		return 0, GetError()
+	}
+	return gain, nil
+}
+
+// SetFrequencyRatio speeds up (ratio > 1) or slows down playback, changing
+// pitch with it. The ratio must be between 0.01 and 100.
+func (s *AudioStream) SetFrequencyRatio(ratio float32) error {
+	if s == nil || s.handle == nil {
+		return fmt.Errorf("audio stream is closed")
+	}
+	if !C.SDL_SetAudioStreamFrequencyRatio(s.handle, C.float(ratio)) {
+		return GetError()
+	}
+	return nil
+}
+
+func (s *AudioStream) FrequencyRatio() (float32, error) {
+	if s == nil || s.handle == nil {
+		return 0, fmt.Errorf("audio stream is closed")
+	}
+	ratio := float32(C.SDL_GetAudioStreamFrequencyRatio(s.handle))
+	if ratio == 0 {
+		return 0, GetError()
+	}
+	return ratio, nil
+}
+
+// SetAudioDeviceGain scales the mixed output of every stream bound to a
+// logical playback device.
+func SetAudioDeviceGain(device AudioDeviceID, gain float32) error {
+	if !C.SDL_SetAudioDeviceGain(C.SDL_AudioDeviceID(device), C.float(gain)) {
+		return GetError()
+	}
+	return nil
+}
+
+func AudioDeviceGain(device AudioDeviceID) (float32, error) {
+	gain := float32(C.SDL_GetAudioDeviceGain(C.SDL_AudioDeviceID(device)))
+	if gain < 0 {
+		return 0, GetError()
+	}
+	return gain, nil
+}
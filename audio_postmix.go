@@ -0,0 +1,62 @@
+package sdl3go
+
+/*
+#include <stdint.h>
+#include <SDL3/SDL.h>
+
+extern void sdl3goAudioPostmixCallback(void *userdata, const SDL_AudioSpec *spec, float *buffer, int buflen);
+
+static bool sdl3go_set_audio_postmix_callback(SDL_AudioDeviceID devid, uintptr_t id) {
+    return SDL_SetAudioPostmixCallback(devid, id ? sdl3goAudioPostmixCallback : NULL, (void *)id);
+}
+*/
+import "C"
+
+import "sync"
+
+// AudioPostmixCallback receives the final mix of a logical device just before
+// it goes to the hardware, as interleaved float32 samples in spec. It runs on
+// SDL's audio thread and must not block. samples is only valid during the
+// call; copy it to keep it. Changes made to samples are heard.
+type AudioPostmixCallback func(spec AudioSpec, samples []float32)
+
+var postmixCallbacks = struct {
+	mu  sync.Mutex
+	ids map[AudioDeviceID]uintptr
+}{ids: make(map[AudioDeviceID]uintptr)}
+
+// SetAudioPostmixCallback taps the output of a logical device from
+// OpenAudioDevice or AudioStream.Device, for meters and visualizers. It also
+// works with the dummy and disk audio drivers. Pass nil to remove the tap;
+// closing the device with CloseAudioDevice, or destroying the stream from
+// OpenAudioStream that owns it, removes it too.
+func SetAudioPostmixCallback(device AudioDeviceID, callback AudioPostmixCallback) error {
+	var id uintptr
+	if callback != nil {
+		id = callbacks.add(callback)
+	}
+	postmixCallbacks.mu.Lock()
+	defer postmixCallbacks.mu.Unlock()
+	if !C.sdl3go_set_audio_postmix_callback(C.SDL_AudioDeviceID(device), C.uintptr_t(id)) {
+		callbacks.remove(id)
+		return GetError()
+	}
+	// SDL holds the device lock while swapping callbacks, so the old one can
+	// no longer be running.
+	callbacks.remove(postmixCallbacks.ids[device])
+	if id == 0 {
+		delete(postmixCallbacks.ids, device)
+	} else {
+		postmixCallbacks.ids[device] = id
+	}
+	return nil
+}
+
+// releasePostmixCallback forgets the tap on a device that has been closed.
+// It is a no-op for devices without one.
+func releasePostmixCallback(device AudioDeviceID) {
+	postmixCallbacks.mu.Lock()
+	defer postmixCallbacks.mu.Unlock()
+	callbacks.remove(postmixCallbacks.ids[device])
+	delete(postmixCallbacks.ids, device)
+}
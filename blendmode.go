@@ -0,0 +1,20 @@
+package sdl3go
+
+/*
+#include <SDL3/SDL.h>
+*/
+import "C"
+
+// BlendMode selects how drawn pixels combine with the destination.
+type BlendMode uint32
+
+const (
+	BLENDMODE_NONE                BlendMode = C.SDL_BLENDMODE_NONE
+	BLENDMODE_BLEND               BlendMode = C.SDL_BLENDMODE_BLEND
+	BLENDMODE_BLEND_PREMULTIPLIED BlendMode = C.SDL_BLENDMODE_BLEND_PREMULTIPLIED
+	BLENDMODE_ADD                 BlendMode = C.SDL_BLENDMODE_ADD
+	BLENDMODE_ADD_PREMULTIPLIED   BlendMode = C.SDL_BLENDMODE_ADD_PREMULTIPLIED
+	BLENDMODE_MOD                 BlendMode = C.SDL_BLENDMODE_MOD
+	BLENDMODE_MUL                 BlendMode = C.SDL_BLENDMODE_MUL
+	BLENDMODE_INVALID             BlendMode = C.SDL_BLENDMODE_INVALID
+)
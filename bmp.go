@@ -0,0 +1,74 @@
+package sdl3go
+
+/*
+#include <stdlib.h>
+#include <SDL3/SDL.h>
+*/
+import "C"
+
+import (
+	"fmt"
+	"io"
+	"unsafe"
+)
+
+func LoadBMP(path string) (*Surface, error) {
+	cPath := C.CString(path)
+	defer C.free(unsafe.Pointer(cPath))
+
+	handle := C.SDL_LoadBMP(cPath)
+	if handle == nil {
+		return nil, GetError()
+	}
+	return &Surface{handle: handle}, nil
+}
+
+// LoadBMPFromReader reads a whole BMP file from r.
+func LoadBMPFromReader(r io.Reader) (*Surface, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	stream, release, err := memoryReadStream(data)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	handle := C.SDL_LoadBMP_IO(stream, true)
+	if handle == nil {
+		return nil, GetError()
+	}
+	return &Surface{handle: handle}, nil
+}
+
+func (s *Surface) SaveBMP(path string) error {
+	if s == nil || s.handle == nil {
+		return fmt.Errorf("surface is destroyed")
+	}
+	cPath := C.CString(path)
+	defer C.free(unsafe.Pointer(cPath))
+
+	if !C.SDL_SaveBMP(s.handle, cPath) {
+		return GetError()
+	}
+	return nil
+}
+
+// SaveBMPToWriter encodes the surface as BMP and writes it to w.
+func (s *Surface) SaveBMPToWriter(w io.Writer) error {
+	if s == nil || s.handle == nil {
+		return fmt.Errorf("surface is destroyed")
+	}
+	stream, err := memoryWriteStream()
+	if err != nil {
+		return err
+	}
+	defer C.SDL_CloseIO(stream)
+
+	if !C.SDL_SaveBMP_IO(s.handle, stream, false) {
+		return GetError()
+	}
+	_, err = w.Write(memoryStreamBytes(stream))
+	return err
+}
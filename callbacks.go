@@ -0,0 +1,37 @@
+package sdl3go
+
+import "sync"
+
+// callbackRegistry maps small integer IDs to Go values so that C code can
+// carry a reference to a Go callback as its userdata pointer without holding
+// a Go pointer. ID 0 is never used.
+type callbackRegistry struct {
+	mu      sync.RWMutex
+	next    uintptr
+	entries map[uintptr]any
+}
+
+var callbacks = callbackRegistry{entries: make(map[uintptr]any)}
+
+func (r *callbackRegistry) add(value any) uintptr {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.next++
+	r.entries[r.next] = value
+	return r.next
+}
+
+func (r *callbackRegistry) get(id uintptr) any {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.entries[id]
+}
+
+func (r *callbackRegistry) remove(id uintptr) {
+	if id == 0 {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.entries, id)
+}
@@ -0,0 +1,176 @@
+package sdl3go
+
+/*
+#include <stdlib.h>
+#include <SDL3/SDL.h>
+
+static SDL_Renderer *sdl3go_create_renderer_with_colorspace(SDL_Window *window, const char *name, SDL_Colorspace colorspace) {
+    SDL_PropertiesID props = SDL_CreateProperties();
+    if (props == 0) {
+        return NULL;
+    }
+    SDL_SetPointerProperty(props, SDL_PROP_RENDERER_CREATE_WINDOW_POINTER, window);
+    if (name != NULL) {
+        SDL_SetStringProperty(props, SDL_PROP_RENDERER_CREATE_NAME_STRING, name);
+    }
+    SDL_SetNumberProperty(props, SDL_PROP_RENDERER_CREATE_OUTPUT_COLORSPACE_NUMBER, colorspace);
+    SDL_Renderer *renderer = SDL_CreateRendererWithProperties(props);
+    SDL_DestroyProperties(props);
+    return renderer;
+}
+
+static SDL_Texture *sdl3go_create_texture_with_colorspace(SDL_Renderer *renderer, SDL_PixelFormat format,
+        SDL_TextureAccess access, int w, int h, SDL_Colorspace colorspace) {
+    SDL_PropertiesID props = SDL_CreateProperties();
+    if (props == 0) {
+        return NULL;
+    }
+    SDL_SetNumberProperty(props, SDL_PROP_TEXTURE_CREATE_FORMAT_NUMBER, format);
+    SDL_SetNumberProperty(props, SDL_PROP_TEXTURE_CREATE_ACCESS_NUMBER, access);
+    SDL_SetNumberProperty(props, SDL_PROP_TEXTURE_CREATE_WIDTH_NUMBER, w);
+    SDL_SetNumberProperty(props, SDL_PROP_TEXTURE_CREATE_HEIGHT_NUMBER, h);
+    SDL_SetNumberProperty(props, SDL_PROP_TEXTURE_CREATE_COLORSPACE_NUMBER, colorspace);
+    SDL_Texture *texture = SDL_CreateTextureWithProperties(renderer, props);
+    SDL_DestroyProperties(props);
+    return texture;
+}
+
+static SDL_Colorspace sdl3go_texture_colorspace(SDL_Texture *texture) {
+    return (SDL_Colorspace)SDL_GetNumberProperty(SDL_GetTextureProperties(texture), SDL_PROP_TEXTURE_COLORSPACE_NUMBER, SDL_COLORSPACE_UNKNOWN);
+}
+
+static SDL_Colorspace sdl3go_renderer_output_colorspace(SDL_Renderer *renderer) {
+    return (SDL_Colorspace)SDL_GetNumberProperty(SDL_GetRendererProperties(renderer), SDL_PROP_RENDERER_OUTPUT_COLORSPACE_NUMBER, SDL_COLORSPACE_SRGB);
+}
+*/
+import "C"
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// Colorspace describes how pixel values map to light (SDL_Colorspace).
+type Colorspace uint32
+
+const (
+	COLORSPACE_UNKNOWN Colorspace = C.SDL_COLORSPACE_UNKNOWN
+
+	// sRGB primaries with the sRGB transfer function; ordinary SDR content.
+	COLORSPACE_SRGB Colorspace = C.SDL_COLORSPACE_SRGB
+	// sRGB primaries with linear transfer. This is scRGB: 1.0 is SDR white
+	// and values above 1.0 use the display's HDR headroom.
+	COLORSPACE_SRGB_LINEAR Colorspace = C.SDL_COLORSPACE_SRGB_LINEAR
+	// BT.2020 primaries with the PQ transfer function (HDR10).
+	COLORSPACE_HDR10 Colorspace = C.SDL_COLORSPACE_HDR10
+
+	// YUV colorspaces
+	COLORSPACE_JPEG           Colorspace = C.SDL_COLORSPACE_JPEG
+	COLORSPACE_BT601_LIMITED  Colorspace = C.SDL_COLORSPACE_BT601_LIMITED
+	COLORSPACE_BT601_FULL     Colorspace = C.SDL_COLORSPACE_BT601_FULL
+	COLORSPACE_BT709_LIMITED  Colorspace = C.SDL_COLORSPACE_BT709_LIMITED
+	COLORSPACE_BT709_FULL     Colorspace = C.SDL_COLORSPACE_BT709_FULL
+	COLORSPACE_BT2020_LIMITED Colorspace = C.SDL_COLORSPACE_BT2020_LIMITED
+	COLORSPACE_BT2020_FULL    Colorspace = C.SDL_COLORSPACE_BT2020_FULL
+
+	COLORSPACE_RGB_DEFAULT Colorspace = C.SDL_COLORSPACE_RGB_DEFAULT
+	COLORSPACE_YUV_DEFAULT Colorspace = C.SDL_COLORSPACE_YUV_DEFAULT
+)
+
+// SetColorspace tags the surface's pixels with colorspace. It does not change
+// the pixels; blits and conversions use it to convert between surfaces.
+func (s *Surface) SetColorspace(colorspace Colorspace) error {
+	if s == nil || s.handle == nil {
+		return fmt.Errorf("surface is destroyed")
+	}
+	if !C.SDL_SetSurfaceColorspace(s.handle, C.SDL_Colorspace(colorspace)) {
+		return GetError()
+	}
+	return nil
+}
+
+func (s *Surface) Colorspace() Colorspace {
+	if s == nil || s.handle == nil {
+		return COLORSPACE_UNKNOWN
+	}
+	return Colorspace(C.SDL_GetSurfaceColorspace(s.handle))
+}
+
+// CreateTextureWithColorspace is CreateTexture for content that is not
+// sRGB, e.g. COLORSPACE_SRGB_LINEAR with PIXELFORMAT_RGBA64_FLOAT for scRGB
+// or COLORSPACE_HDR10 with PIXELFORMAT_ABGR2101010. A texture's colorspace is
+// fixed at creation.
+func CreateTextureWithColorspace(renderer *Renderer, format PixelFormat, access TextureAccess, width, height int, colorspace Colorspace) (*Texture, error) {
+	if renderer == nil || renderer.handle == nil {
+		return nil, fmt.Errorf("renderer is destroyed")
+	}
+	if width <= 0 || height <= 0 {
+		return nil, fmt.Errorf("invalid texture size %dx%d", width, height)
+	}
+	handle := C.sdl3go_create_texture_with_colorspace(renderer.handle, C.SDL_PixelFormat(format), C.SDL_TextureAccess(access),
+		C.int(width), C.int(height), C.SDL_Colorspace(colorspace))
+	if handle == nil {
+		return nil, GetError()
+	}
+	return &Texture{handle: handle}, nil
+}
+
+func (t *Texture) Colorspace() Colorspace {
+	if t == nil || t.handle == nil {
+		return COLORSPACE_UNKNOWN
+	}
+	return Colorspace(C.sdl3go_texture_colorspace(t.handle))
+}
+
+// CreateRendererWithColorspace is CreateRenderer with a chosen output
+// colorspace. Use COLORSPACE_SRGB_LINEAR to render HDR: draw colors and
+// textures are then blended in linear light and values above 1.0, up to
+// Window.HDRState().Headroom, reach the display's HDR range. Only
+// COLORSPACE_SRGB and COLORSPACE_SRGB_LINEAR are accepted.
+func CreateRendererWithColorspace(window *Window, driverName string, colorspace Colorspace) (*Renderer, error) {
+	if window == nil || window.handle == nil {
+		return nil, fmt.Errorf("window is destroyed")
+	}
+	var cName *C.char
+	if driverName != "" {
+		cName = C.CString(driverName)
+		defer C.free(unsafe.Pointer(cName))
+	}
+	handle := C.sdl3go_create_renderer_with_colorspace(window.handle, cName, C.SDL_Colorspace(colorspace))
+	if handle == nil {
+		return nil, GetError()
+	}
+	return &Renderer{handle: handle}, nil
+}
+
+func (r *Renderer) OutputColorspace() Colorspace {
+	if r == nil || r.handle == nil {
+		return COLORSPACE_UNKNOWN
+	}
+	return Colorspace(C.sdl3go_renderer_output_colorspace(r.handle))
+}
+
+// SetColorScale multiplies all drawn colors by scale after they are converted
+// to the output colorspace. It is an extra brightness factor: with HDR
+// enabled on a linear output, SDL already applies the display's SDR white
+// point on top of it, so leave it at 1 unless you want to dim or boost.
+func (r *Renderer) SetColorScale(scale float32) error {
+	if r == nil || r.handle == nil {
+		return fmt.Errorf("renderer is destroyed")
+	}
+	if !C.SDL_SetRenderColorScale(r.handle, C.float(scale)) {
+		return GetError()
+	}
+	return nil
+}
+
+func (r *Renderer) ColorScale() (float32, error) {
+	if r == nil || r.handle == nil {
+		return 0, fmt.Errorf("renderer is destroyed")
+	}
+	var scale C.float
+	if !C.SDL_GetRenderColorScale(r.handle, &scale) {
+		return 0, GetError()
+	}
+	return float32(scale), nil
+}
@@ -34,6 +34,7 @@ const (
 	EVENT_WINDOW_RESIZED            EventType = C.SDL_EVENT_WINDOW_RESIZED
 	EVENT_WINDOW_PIXEL_SIZE_CHANGED EventType = C.SDL_EVENT_WINDOW_PIXEL_SIZE_CHANGED
 	EVENT_WINDOW_HDR_STATE_CHANGED  EventType = C.SDL_EVENT_WINDOW_HDR_STATE_CHANGED
+	EVENT_WINDOW_ICCPROF_CHANGED    EventType = C.SDL_EVENT_WINDOW_ICCPROF_CHANGED
 	EVENT_KEY_DOWN                  EventType = C.SDL_EVENT_KEY_DOWN
 	EVENT_KEY_UP                    EventType = C.SDL_EVENT_KEY_UP
 	EVENT_MOUSE_MOTION              EventType = C.SDL_EVENT_MOUSE_MOTION
@@ -53,7 +54,12 @@ const (
 	EVENT_DROP_TEXT                 EventType = C.SDL_EVENT_DROP_TEXT
 	EVENT_DROP_COMPLETE             EventType = C.SDL_EVENT_DROP_COMPLETE
 	EVENT_DROP_POSITION             EventType = C.SDL_EVENT_DROP_POSITION
-	EVENT_LAST                      EventType = C.SDL_EVENT_LAST
+
+	EVENT_AUDIO_DEVICE_ADDED          EventType = C.SDL_EVENT_AUDIO_DEVICE_ADDED
+	EVENT_AUDIO_DEVICE_REMOVED        EventType = C.SDL_EVENT_AUDIO_DEVICE_REMOVED
+	EVENT_AUDIO_DEVICE_FORMAT_CHANGED EventType = C.SDL_EVENT_AUDIO_DEVICE_FORMAT_CHANGED
+
+	EVENT_LAST EventType = C.SDL_EVENT_LAST
 )
 
 type Event struct {
@@ -79,6 +85,9 @@ type Event struct {
 
 	// Drag-and-drop events
 	Drop *DropEvent
+
+	// Audio device events
+	AudioDevice *AudioDeviceEvent
 }
 
 func PumpEvents() {
@@ -105,7 +114,7 @@ func PollEvent() (*Event, bool) {
 	}
 
 	switch event.Type {
-	case EVENT_WINDOW_RESIZED, EVENT_WINDOW_PIXEL_SIZE_CHANGED, EVENT_WINDOW_HDR_STATE_CHANGED:
+	case EVENT_WINDOW_RESIZED, EVENT_WINDOW_PIXEL_SIZE_CHANGED, EVENT_WINDOW_HDR_STATE_CHANGED, EVENT_WINDOW_ICCPROF_CHANGED:
 		event.Window = parseWindowEvent(&cevent)
 	case EVENT_KEY_DOWN, EVENT_KEY_UP:
 		event.Keyboard = parseKeyboardEvent(&cevent)
@@ -127,6 +136,8 @@ func PollEvent() (*Event, bool) {
 		event.PenAxis = parsePenAxisEvent(&cevent)
 	case EVENT_DROP_BEGIN, EVENT_DROP_FILE, EVENT_DROP_TEXT, EVENT_DROP_COMPLETE, EVENT_DROP_POSITION:
 		event.Drop = parseDropEvent(&cevent)
+	case EVENT_AUDIO_DEVICE_ADDED, EVENT_AUDIO_DEVICE_REMOVED, EVENT_AUDIO_DEVICE_FORMAT_CHANGED:
+		event.AudioDevice = parseAudioDeviceEvent(&cevent)
 	}
 
 	return event, true
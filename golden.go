@@ -0,0 +1,88 @@
+package sdl3go
+
+import (
+	"fmt"
+	"image"
+	"image/png"
+	"os"
+)
+
+// GoldenMismatch is returned by CompareGolden when an image differs from its
+// reference by more than the allowed tolerance.
+type GoldenMismatch struct {
+	Path       string
+	Mismatched int         // Pixels with a channel outside the tolerance
+	MaxDelta   uint8       // Largest per-channel difference seen
+	First      image.Point // First mismatching pixel, relative to the image origin
+}
+
+func (e *GoldenMismatch) Error() string {
+	return fmt.Sprintf("%s: %d pixels differ (max channel delta %d, first at %v)", e.Path, e.Mismatched, e.MaxDelta, e.First)
+}
+
+// CompareGolden compares img against the PNG at path. Each 8-bit channel may
+// differ by up to tolerance. A size mismatch or unreadable file is reported
+// as a plain error, a pixel mismatch as *GoldenMismatch.
+//
+// A typical golden test renders with CreateSoftwareRenderer under
+// SDL_VIDEODRIVER=dummy, reads the result back with Renderer.ReadPixels and
+// calls SaveGolden instead of CompareGolden when refreshing references.
+func CompareGolden(path string, img image.Image, tolerance uint8) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	want, err := png.Decode(file)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	gotBounds, wantBounds := img.Bounds(), want.Bounds()
+	if gotBounds.Dx() != wantBounds.Dx() || gotBounds.Dy() != wantBounds.Dy() {
+		return fmt.Errorf("%s: image is %dx%d, golden is %dx%d", path, gotBounds.Dx(), gotBounds.Dy(), wantBounds.Dx(), wantBounds.Dy())
+	}
+
+	mismatch := GoldenMismatch{Path: path}
+	for y := 0; y < gotBounds.Dy(); y++ {
+		for x := 0; x < gotBounds.Dx(); x++ {
+			gr, gg, gb, ga := img.At(gotBounds.Min.X+x, gotBounds.Min.Y+y).RGBA()
+			wr, wg, wb, wa := want.At(wantBounds.Min.X+x, wantBounds.Min.Y+y).RGBA()
+			delta := max(channelDelta(gr, wr), channelDelta(gg, wg), channelDelta(gb, wb), channelDelta(ga, wa))
+			if delta <= tolerance {
+				continue
+			}
+			if mismatch.Mismatched == 0 {
+				mismatch.First = image.Pt(x, y)
+			}
+			mismatch.Mismatched++
+			mismatch.MaxDelta = max(mismatch.MaxDelta, delta)
+		}
+	}
+	if mismatch.Mismatched > 0 {
+		return &mismatch
+	}
+	return nil
+}
+
+// SaveGolden writes img to path as a PNG reference for CompareGolden.
+func SaveGolden(path string, img image.Image) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(file, img); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// channelDelta compares two 16-bit color channels at 8-bit precision.
+func channelDelta(a, b uint32) uint8 {
+	a, b = a>>8, b>>8
+	if a > b {
+		return uint8(a - b)
+	}
+	return uint8(b - a)
+}
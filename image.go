@@ -0,0 +1,190 @@
+package sdl3go
+
+import (
+	"bufio"
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	"io"
+	"os"
+	"sync"
+)
+
+// ErrUnknownImageFormat is returned by LoadSurface when no registered codec
+// matches the data.
+var ErrUnknownImageFormat = errors.New("unknown image format")
+
+// ImageDecoder decodes one image from r.
+type ImageDecoder func(r io.Reader) (image.Image, error)
+
+type imageCodec struct {
+	name   string
+	magic  string
+	decode ImageDecoder
+}
+
+var (
+	imageCodecsMu sync.RWMutex
+	imageCodecs   []imageCodec
+)
+
+// RegisterImageCodec makes LoadSurface recognize files whose first bytes
+// match magic, in which '?' matches any byte. Codecs registered later win
+// over earlier ones with the same magic, so applications can replace the
+// built-in BMP loader. For example:
+//
+//	sdl3go.RegisterImageCodec("png", "\x89PNG\r\n\x1a\n", png.Decode)
+//	sdl3go.RegisterImageCodec("jpeg", "\xff\xd8", jpeg.Decode)
+//	sdl3go.RegisterImageCodec("qoi", "qoif", qoi.Decode)
+func RegisterImageCodec(name, magic string, decode ImageDecoder) {
+	imageCodecsMu.Lock()
+	defer imageCodecsMu.Unlock()
+	imageCodecs = append(imageCodecs, imageCodec{name: name, magic: magic, decode: decode})
+}
+
+// bmpMagic is handled by SDL itself, so BMP files load into a surface in
+// their stored pixel format without going through image.Image.
+const bmpMagic = "BM"
+
+// LoadSurface loads an image file into a new surface, choosing the codec by
+// the file's magic bytes. BMP is always supported; other formats must be
+// registered with RegisterImageCodec.
+func LoadSurface(path string) (*Surface, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return LoadSurfaceFromReader(file)
+}
+
+// LoadSurfaceFromReader is LoadSurface for data from r.
+func LoadSurfaceFromReader(r io.Reader) (*Surface, error) {
+	reader := bufio.NewReader(r)
+	if codec, ok := matchImageCodec(reader); ok {
+		img, err := codec.decode(reader)
+		if err != nil {
+			return nil, err
+		}
+		return SurfaceFromImage(img)
+	}
+	if header, err := reader.Peek(len(bmpMagic)); err == nil && string(header) == bmpMagic {
+		return LoadBMPFromReader(reader)
+	}
+	return nil, ErrUnknownImageFormat
+}
+
+// matchImageCodec returns the most recently registered codec whose magic
+// matches the start of r, without consuming any input.
+func matchImageCodec(r *bufio.Reader) (imageCodec, bool) {
+	imageCodecsMu.RLock()
+	defer imageCodecsMu.RUnlock()
+	for i := len(imageCodecs) - 1; i >= 0; i-- {
+		codec := imageCodecs[i]
+		header, err := r.Peek(len(codec.magic))
+		if err != nil || !magicMatches(codec.magic, header) {
+			continue
+		}
+		return codec, true
+	}
+	return imageCodec{}, false
+}
+
+func magicMatches(magic string, header []byte) bool {
+	if len(header) != len(magic) {
+		return false
+	}
+	for i := range header {
+		if magic[i] != '?' && magic[i] != header[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// imagePixels returns the pixels of img as rows of RGBA32 bytes. *image.RGBA
+// and *image.NRGBA are returned in place. Every other common decoder output
+// is converted once without per-pixel color.Color calls: opaque types
+// through image/draw's fast paths into an *image.RGBA, palettes through a
+// lookup table, and 16-bit types by keeping the high byte. premultiplied
+// reports whether the color channels are multiplied by alpha, as they are
+// in image.RGBA; opaque images report false since both forms are equal.
+func imagePixels(img image.Image) (pix []byte, stride int, premultiplied bool) {
+	bounds := img.Bounds()
+	switch src := img.(type) {
+	case *image.RGBA:
+		return src.Pix[src.PixOffset(bounds.Min.X, bounds.Min.Y):], src.Stride, true
+	case *image.NRGBA:
+		return src.Pix[src.PixOffset(bounds.Min.X, bounds.Min.Y):], src.Stride, false
+	case *image.YCbCr, *image.Gray, *image.CMYK:
+		dst := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+		draw.Draw(dst, dst.Bounds(), img, bounds.Min, draw.Src)
+		return dst.Pix, dst.Stride, false
+	case *image.Paletted:
+		return palettedPixels(src), bounds.Dx() * 4, false
+	case *image.RGBA64:
+		return wideRGBAPixels(src.Pix[src.PixOffset(bounds.Min.X, bounds.Min.Y):], src.Stride, bounds), bounds.Dx() * 4, true
+	case *image.NRGBA64:
+		return wideRGBAPixels(src.Pix[src.PixOffset(bounds.Min.X, bounds.Min.Y):], src.Stride, bounds), bounds.Dx() * 4, false
+	case *image.Gray16:
+		return gray16Pixels(src), bounds.Dx() * 4, false
+	}
+	dst := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(dst, dst.Bounds(), img, bounds.Min, draw.Src)
+	return dst.Pix, dst.Stride, false
+}
+
+// palettedPixels expands src through its palette, converted to straight
+// RGBA once. Indices outside the palette become transparent black.
+func palettedPixels(src *image.Paletted) []byte {
+	var lut [256][4]byte
+	for i, c := range src.Palette {
+		if i == len(lut) {
+			break
+		}
+		n := color.NRGBAModel.Convert(c).(color.NRGBA)
+		lut[i] = [4]byte{n.R, n.G, n.B, n.A}
+	}
+	bounds := src.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	pix := make([]byte, w*h*4)
+	for y := 0; y < h; y++ {
+		row := src.Pix[src.PixOffset(bounds.Min.X, bounds.Min.Y+y):][:w]
+		out := pix[y*w*4:]
+		for x, index := range row {
+			copy(out[x*4:x*4+4], lut[index][:])
+		}
+	}
+	return pix
+}
+
+// wideRGBAPixels narrows 16-bit big-endian RGBA rows to RGBA32 by keeping
+// the high byte of each channel. Premultiplication is preserved.
+func wideRGBAPixels(src []byte, stride int, bounds image.Rectangle) []byte {
+	w, h := bounds.Dx(), bounds.Dy()
+	pix := make([]byte, w*h*4)
+	for y := 0; y < h; y++ {
+		row := src[y*stride:]
+		out := pix[y*w*4 : (y+1)*w*4]
+		for i := range out {
+			out[i] = row[i*2]
+		}
+	}
+	return pix
+}
+
+func gray16Pixels(src *image.Gray16) []byte {
+	bounds := src.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	pix := make([]byte, w*h*4)
+	for y := 0; y < h; y++ {
+		row := src.Pix[src.PixOffset(bounds.Min.X, bounds.Min.Y+y):]
+		out := pix[y*w*4:]
+		for x := 0; x < w; x++ {
+			v := row[x*2]
+			out[x*4], out[x*4+1], out[x*4+2], out[x*4+3] = v, v, v, 0xff
+		}
+	}
+	return pix
+}
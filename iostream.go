@@ -0,0 +1,53 @@
+package sdl3go
+
+/*
+#include <SDL3/SDL.h>
+
+static void *sdl3go_iostream_dynamic_memory(SDL_IOStream *io) {
+    return SDL_GetPointerProperty(SDL_GetIOProperties(io), SDL_PROP_IOSTREAM_DYNAMIC_MEMORY_POINTER, NULL);
+}
+*/
+import "C"
+
+import (
+	"fmt"
+	"runtime"
+	"unsafe"
+)
+
+// memoryReadStream wraps data in a read-only SDL_IOStream without copying.
+// data stays pinned until release is called, which must happen after SDL has
+// closed the stream.
+func memoryReadStream(data []byte) (stream *C.SDL_IOStream, release func(), err error) {
+	if len(data) == 0 {
+		return nil, nil, fmt.Errorf("no data")
+	}
+	pinner := new(runtime.Pinner)
+	pinner.Pin(&data[0])
+	stream = C.SDL_IOFromConstMem(unsafe.Pointer(&data[0]), C.size_t(len(data)))
+	if stream == nil {
+		pinner.Unpin()
+		return nil, nil, GetError()
+	}
+	return stream, pinner.Unpin, nil
+}
+
+// memoryWriteStream returns an SDL_IOStream that grows in SDL-owned memory.
+// Collect the output with memoryStreamBytes before closing it.
+func memoryWriteStream() (*C.SDL_IOStream, error) {
+	stream := C.SDL_IOFromDynamicMem()
+	if stream == nil {
+		return nil, GetError()
+	}
+	return stream, nil
+}
+
+// memoryStreamBytes copies everything written to a memoryWriteStream.
+func memoryStreamBytes(stream *C.SDL_IOStream) []byte {
+	size := int(C.SDL_GetIOSize(stream))
+	data := C.sdl3go_iostream_dynamic_memory(stream)
+	if size <= 0 || data == nil {
+		return nil
+	}
+	return C.GoBytes(data, C.int(size))
+}
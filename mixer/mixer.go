@@ -0,0 +1,322 @@
+// Package mixer is a small voice mixer on top of sdl3go audio streams.
+//
+// A Mixer plays Sounds on named voices, each with its own volume, pan and
+// fades, optionally routed through named buses with their own gain. The
+// output is interleaved stereo float32 at the mixer's sample rate. Render
+// pulls it directly, so mixes can be produced offline; the package does not
+// depend on SDL. Package sdlmixer feeds a Mixer to an sdl3go AudioStream on
+// demand and loads sounds through SDL.
+package mixer
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sync"
+	"time"
+)
+
+// OutputChannels is the number of interleaved channels Render produces.
+const OutputChannels = 2
+
+// ErrNoVoice is returned by Play when every voice is busy with a sound of
+// higher priority.
+var ErrNoVoice = errors.New("mixer: no voice available")
+
+// Sound is audio ready for playback at the mixer's sample rate: mono or
+// stereo interleaved float32. A Sound is never modified by the mixer and
+// can be played on many voices at once.
+type Sound struct {
+	channels int
+	samples  []float32
+}
+
+func NewSound(channels int, samples []float32) (*Sound, error) {
+	if channels != 1 && channels != 2 {
+		return nil, fmt.Errorf("mixer: sounds must be mono or stereo, got %d channels", channels)
+	}
+	if len(samples)%channels != 0 {
+		return nil, fmt.Errorf("mixer: %d samples is not a whole number of %d-channel frames", len(samples), channels)
+	}
+	return &Sound{channels: channels, samples: samples}, nil
+}
+
+func (s *Sound) Channels() int {
+	return s.channels
+}
+
+func (s *Sound) Frames() int {
+	return len(s.samples) / s.channels
+}
+
+// bus groups voices under a shared gain, e.g. "music", "sfx" and "voice".
+type bus struct {
+	gain float32
+}
+
+// PlayOptions configures a new voice. The zero value, like a nil
+// *PlayOptions, plays at unity volume, centred, once, on no bus.
+type PlayOptions struct {
+	Bus      string   // Bus to route through; empty for none
+	Volume   *float32 // Linear gain; nil is unity, see Volume
+	Pan      float32  // -1 is full left, 0 centre, 1 full right
+	Loop     bool
+	FadeIn   time.Duration
+	Priority int // When the voice cap is reached, lower priorities are stolen first
+}
+
+var defaultPlayOptions PlayOptions
+
+// Volume returns a pointer to volume for PlayOptions.Volume. The field is a
+// pointer so that options which leave it out play at unity rather than
+// silently; use Volume(0) to start a voice muted.
+func Volume(volume float32) *float32 {
+	return &volume
+}
+
+// Mixer mixes voices into stereo float32. All methods are safe for
+// concurrent use, including from SDL's audio thread.
+type Mixer struct {
+	mu        sync.Mutex
+	rate      int
+	maxVoices int
+	gain      float32
+	applied   float32
+	buses     map[string]*bus
+	voices    []*Voice
+	serial    uint64
+}
+
+// New creates a mixer at sampleRate that plays at most maxVoices voices at
+// once. When all are busy, Play steals the least important one.
+func New(sampleRate, maxVoices int) (*Mixer, error) {
+	if sampleRate <= 0 {
+		return nil, fmt.Errorf("mixer: invalid sample rate %d", sampleRate)
+	}
+	if maxVoices <= 0 {
+		return nil, fmt.Errorf("mixer: invalid voice cap %d", maxVoices)
+	}
+	return &Mixer{
+		rate:      sampleRate,
+		maxVoices: maxVoices,
+		gain:      1,
+		applied:   1,
+		buses:     make(map[string]*bus),
+	}, nil
+}
+
+func (m *Mixer) SampleRate() int {
+	return m.rate
+}
+
+// SetGain sets the master gain applied after all buses.
+func (m *Mixer) SetGain(gain float32) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.gain = gain
+}
+
+func (m *Mixer) Gain() float32 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.gain
+}
+
+// SetBusGain sets the gain of the named bus, creating it if needed.
+func (m *Mixer) SetBusGain(name string, gain float32) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.bus(name).gain = gain
+}
+
+// BusGain returns the gain of the named bus; buses that were never set have
+// unity gain.
+func (m *Mixer) BusGain(name string) float32 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if b, ok := m.buses[name]; ok {
+		return b.gain
+	}
+	return 1
+}
+
+func (m *Mixer) bus(name string) *bus {
+	b, ok := m.buses[name]
+	if !ok {
+		b = &bus{gain: 1}
+		m.buses[name] = b
+	}
+	return b
+}
+
+// Play starts sound on a new voice called name. Several voices may share a
+// name; Stop and Playing act on all of them. If the voice cap is reached the
+// voice with the lowest priority is stolen, preferring voices already fading
+// out and then the oldest, unless every voice outranks opts.Priority.
+func (m *Mixer) Play(name string, sound *Sound, opts *PlayOptions) (*Voice, error) {
+	if sound == nil {
+		return nil, fmt.Errorf("mixer: nil sound")
+	}
+	if opts == nil {
+		opts = &defaultPlayOptions
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.voices) >= m.maxVoices {
+		victim := m.stealCandidate()
+		if !victim.stopping && victim.priority > opts.Priority {
+			return nil, ErrNoVoice
+		}
+		m.remove(victim)
+	}
+	m.serial++
+	v := &Voice{
+		m:        m,
+		name:     name,
+		sound:    sound,
+		loop:     opts.Loop,
+		volume:   1,
+		pan:      clampPan(opts.Pan),
+		priority: opts.Priority,
+		serial:   m.serial,
+		fade:     1,
+	}
+	if opts.Volume != nil {
+		v.volume = *opts.Volume
+	}
+	if opts.Bus != "" {
+		v.bus = m.bus(opts.Bus)
+	}
+	if opts.FadeIn > 0 {
+		v.fade = 0
+		v.fadeTo(1, m.frames(opts.FadeIn))
+	}
+	// Start at the target gains so the first block does not ramp up from
+	// silence unless a fade was asked for.
+	v.appliedL, v.appliedR = v.targetGains()
+	m.voices = append(m.voices, v)
+	return v, nil
+}
+
+func (m *Mixer) stealCandidate() *Voice {
+	victim := m.voices[0]
+	for _, v := range m.voices[1:] {
+		switch {
+		case v.stopping != victim.stopping:
+			if v.stopping {
+				victim = v
+			}
+		case v.priority != victim.priority:
+			if v.priority < victim.priority {
+				victim = v
+			}
+		case v.serial < victim.serial:
+			victim = v
+		}
+	}
+	return victim
+}
+
+// remove releases victim at once. slices.Delete clears the vacated slot so
+// the released voice is not kept alive.
+func (m *Mixer) remove(victim *Voice) {
+	victim.done = true
+	if i := slices.Index(m.voices, victim); i >= 0 {
+		m.voices = slices.Delete(m.voices, i, i+1)
+	}
+}
+
+// Stop stops every voice called name, fading out over fade.
+func (m *Mixer) Stop(name string, fade time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stopWhere(fade, func(v *Voice) bool { return v.name == name })
+}
+
+// StopAll stops every voice, fading out over fade.
+func (m *Mixer) StopAll(fade time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stopWhere(fade, func(*Voice) bool { return true })
+}
+
+// stopWhere collects the matching voices before stopping them, because a
+// voice stopped without a fade is removed from m.voices straight away.
+func (m *Mixer) stopWhere(fade time.Duration, match func(*Voice) bool) {
+	var matched []*Voice
+	for _, v := range m.voices {
+		if match(v) {
+			matched = append(matched, v)
+		}
+	}
+	for _, v := range matched {
+		v.stop(fade)
+	}
+}
+
+// Playing reports whether any voice called name is still sounding,
+// including while it fades out.
+func (m *Mixer) Playing(name string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, v := range m.voices {
+		if v.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ActiveVoices returns the number of voices currently playing.
+func (m *Mixer) ActiveVoices() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.voices)
+}
+
+// Render mixes the next len(out)/OutputChannels frames into out, replacing
+// its contents, and advances every voice. Finished voices are released.
+func (m *Mixer) Render(out []float32) {
+	frames := len(out) / OutputChannels
+	out = out[:frames*OutputChannels]
+	clear(out)
+	if frames == 0 {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	live := m.voices[:0]
+	for _, v := range m.voices {
+		v.mix(out, frames)
+		if !v.done {
+			live = append(live, v)
+		}
+	}
+	clear(m.voices[len(live):])
+	m.voices = live
+	rampGain(out, m.applied, m.gain)
+	m.applied = m.gain
+}
+
+func (m *Mixer) frames(d time.Duration) int {
+	return int(math.Round(d.Seconds() * float64(m.rate)))
+}
+
+// rampGain scales stereo frames by a gain moving linearly from start to end,
+// which avoids clicks when gains change between blocks.
+func rampGain(out []float32, start, end float32) {
+	if start == 1 && end == 1 {
+		return
+	}
+	frames := len(out) / OutputChannels
+	for i := 0; i < frames; i++ {
+		g := start + (end-start)*float32(i+1)/float32(frames)
+		out[2*i] *= g
+		out[2*i+1] *= g
+	}
+}
+
+func clampPan(pan float32) float32 {
+	return max(-1, min(1, pan))
+}
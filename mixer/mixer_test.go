@@ -0,0 +1,260 @@
+package mixer
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+// testRate makes one millisecond exactly one frame.
+const testRate = 1000
+
+func newTestMixer(t *testing.T, maxVoices int) *Mixer {
+	t.Helper()
+	m, err := New(testRate, maxVoices)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func constSound(t *testing.T, channels, frames int, value float32) *Sound {
+	t.Helper()
+	samples := make([]float32, channels*frames)
+	for i := range samples {
+		samples[i] = value
+	}
+	s, err := NewSound(channels, samples)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func play(t *testing.T, m *Mixer, name string, sound *Sound, opts *PlayOptions) *Voice {
+	t.Helper()
+	v, err := m.Play(name, sound, opts)
+	if err != nil {
+		t.Fatalf("Play(%q): %v", name, err)
+	}
+	return v
+}
+
+func render(m *Mixer, frames int) []float32 {
+	out := make([]float32, frames*OutputChannels)
+	m.Render(out)
+	return out
+}
+
+func near(a, b float32) bool {
+	return math.Abs(float64(a-b)) < 1e-5
+}
+
+func checkFrame(t *testing.T, out []float32, frame int, l, r float32) {
+	t.Helper()
+	gotL, gotR := out[2*frame], out[2*frame+1]
+	if !near(gotL, l) || !near(gotR, r) {
+		t.Errorf("frame %d = (%v, %v), want (%v, %v)", frame, gotL, gotR, l, r)
+	}
+}
+
+var centre = float32(math.Sqrt2 / 2)
+
+func TestPanLaw(t *testing.T) {
+	tests := []struct {
+		channels int
+		pan      float32
+		l, r     float32
+	}{
+		{1, 0, centre, centre},
+		{1, -1, 1, 0},
+		{1, 1, 0, 1},
+		{1, 0.5, float32(math.Cos(3 * math.Pi / 8)), float32(math.Sin(3 * math.Pi / 8))},
+		{2, 0, 1, 1},
+		{2, 0.5, 0.5, 1},
+		{2, -1, 1, 0},
+	}
+	for _, tt := range tests {
+		m := newTestMixer(t, 4)
+		play(t, m, "v", constSound(t, tt.channels, 8, 1), &PlayOptions{Pan: tt.pan})
+		out := render(m, 4)
+		for i := range 4 {
+			checkFrame(t, out, i, tt.l, tt.r)
+		}
+	}
+}
+
+func TestDefaultVolume(t *testing.T) {
+	tests := []struct {
+		name string
+		opts *PlayOptions
+		want float32
+	}{
+		{"nil options", nil, centre},
+		{"volume unset", &PlayOptions{Bus: "sfx", Loop: true}, centre},
+		{"explicit volume", &PlayOptions{Volume: Volume(0.5)}, centre * 0.5},
+		{"muted", &PlayOptions{Volume: Volume(0)}, 0},
+	}
+	for _, tt := range tests {
+		m := newTestMixer(t, 4)
+		play(t, m, "v", constSound(t, 1, 8, 1), tt.opts)
+		out := render(m, 1)
+		if !near(out[0], tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, out[0], tt.want)
+		}
+	}
+}
+
+func TestBusGain(t *testing.T) {
+	m := newTestMixer(t, 4)
+	m.SetBusGain("music", 0.5)
+	play(t, m, "song", constSound(t, 2, 16, 1), &PlayOptions{Bus: "music", Loop: true})
+	play(t, m, "dry", constSound(t, 2, 16, 1), nil)
+	out := render(m, 2)
+	checkFrame(t, out, 0, 1.5, 1.5)
+
+	// A gain change ramps across the next block and then holds.
+	m.SetBusGain("music", 0)
+	out = render(m, 4)
+	checkFrame(t, out, 0, 1.375, 1.375)
+	checkFrame(t, out, 3, 1, 1)
+	out = render(m, 2)
+	checkFrame(t, out, 0, 1, 1)
+
+	m.SetGain(2)
+	render(m, 2)
+	out = render(m, 2)
+	checkFrame(t, out, 0, 2, 2)
+	if got := m.BusGain("unused"); got != 1 {
+		t.Errorf("BusGain of unset bus = %v, want 1", got)
+	}
+}
+
+func TestFadeIn(t *testing.T) {
+	m := newTestMixer(t, 4)
+	play(t, m, "v", constSound(t, 2, 16, 1), &PlayOptions{FadeIn: 4 * time.Millisecond})
+	out := render(m, 6)
+	for i, want := range []float32{0.25, 0.5, 0.75, 1, 1, 1} {
+		checkFrame(t, out, i, want, want)
+	}
+}
+
+func TestFadeOut(t *testing.T) {
+	m := newTestMixer(t, 4)
+	v := play(t, m, "v", constSound(t, 2, 16, 1), &PlayOptions{Loop: true})
+	v.Stop(4 * time.Millisecond)
+	if !v.Playing() {
+		t.Fatal("voice released before its fade out")
+	}
+	out := render(m, 6)
+	for i, want := range []float32{0.75, 0.5, 0.25, 0, 0, 0} {
+		checkFrame(t, out, i, want, want)
+	}
+	if v.Playing() || m.ActiveVoices() != 0 {
+		t.Errorf("voice still playing after its fade out")
+	}
+}
+
+func TestLoop(t *testing.T) {
+	sound, err := NewSound(1, []float32{1, 2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := newTestMixer(t, 4)
+	looped := play(t, m, "loop", sound, &PlayOptions{Loop: true, Pan: -1})
+	once := play(t, m, "once", sound, &PlayOptions{Pan: 1})
+	out := render(m, 7)
+	for i, want := range []float32{1, 2, 3, 1, 2, 3, 1} {
+		var r float32
+		if i < 3 {
+			r = want
+		}
+		checkFrame(t, out, i, want, r)
+	}
+	if once.Playing() || !looped.Playing() {
+		t.Errorf("once playing = %v, looped playing = %v", once.Playing(), looped.Playing())
+	}
+
+	// Turning looping off lets the current pass finish.
+	looped.SetLoop(false)
+	out = render(m, 4)
+	for i, want := range []float32{2, 3, 0, 0} {
+		checkFrame(t, out, i, want, 0)
+	}
+	if looped.Playing() {
+		t.Error("voice still playing after its last pass")
+	}
+}
+
+func TestVoiceStealing(t *testing.T) {
+	sound := constSound(t, 1, 16, 1)
+	m := newTestMixer(t, 2)
+	a := play(t, m, "a", sound, nil)
+	b := play(t, m, "b", sound, &PlayOptions{Priority: 1})
+
+	// The lowest priority goes first.
+	c := play(t, m, "c", sound, nil)
+	if a.Playing() || !b.Playing() {
+		t.Errorf("stole the wrong voice: a playing = %v, b playing = %v", a.Playing(), b.Playing())
+	}
+
+	// Nothing is stolen for a sound that every voice outranks.
+	if _, err := m.Play("d", sound, &PlayOptions{Priority: -1}); !errors.Is(err, ErrNoVoice) {
+		t.Errorf("Play with lowest priority: err = %v, want ErrNoVoice", err)
+	}
+	if m.ActiveVoices() != 2 {
+		t.Errorf("ActiveVoices = %d, want 2", m.ActiveVoices())
+	}
+
+	// Among equal priorities the oldest goes first.
+	play(t, m, "e", sound, &PlayOptions{Priority: 1})
+	play(t, m, "f", sound, &PlayOptions{Priority: 1})
+	if c.Playing() || b.Playing() {
+		t.Errorf("c playing = %v, b playing = %v; want both stolen", c.Playing(), b.Playing())
+	}
+
+	// Voices fading out are stolen before anything else, whatever their
+	// priority.
+	m.Stop("f", time.Second)
+	play(t, m, "g", sound, &PlayOptions{Priority: -5})
+	if m.Playing("f") || !m.Playing("e") {
+		t.Errorf("f playing = %v, e playing = %v", m.Playing("f"), m.Playing("e"))
+	}
+}
+
+func TestStopWithoutFade(t *testing.T) {
+	sound := constSound(t, 1, 16, 1)
+	m := newTestMixer(t, 8)
+	for _, name := range []string{"a", "b", "c"} {
+		play(t, m, name, sound, nil)
+	}
+	m.StopAll(0)
+	if n := m.ActiveVoices(); n != 0 {
+		t.Errorf("ActiveVoices after StopAll(0) = %d, want 0", n)
+	}
+
+	for _, name := range []string{"x", "x", "y"} {
+		play(t, m, name, sound, nil)
+	}
+	m.Stop("x", 0)
+	if m.Playing("x") || !m.Playing("y") {
+		t.Errorf("x playing = %v, y playing = %v", m.Playing("x"), m.Playing("y"))
+	}
+	out := render(m, 1)
+	checkFrame(t, out, 0, centre, centre)
+}
+
+func TestRenderReplacesOutput(t *testing.T) {
+	m := newTestMixer(t, 4)
+	out := []float32{9, 9, 9, 9, 9}
+	m.Render(out)
+	for i, s := range out[:4] {
+		if s != 0 {
+			t.Errorf("sample %d = %v, want 0", i, s)
+		}
+	}
+	if out[4] != 9 {
+		t.Errorf("partial frame was written: %v", out[4])
+	}
+}
@@ -0,0 +1,99 @@
+// Package sdlmixer connects a mixer.Mixer to sdl3go audio streams and loads
+// sounds through SDL. The mixer package itself is pure Go so that mixes can
+// be rendered and tested without SDL.
+package sdlmixer
+
+import (
+	"fmt"
+	"unsafe"
+
+	sdl "github.com/NOT-REAL-GAMES/sdl3go"
+	"github.com/NOT-REAL-GAMES/sdl3go/mixer"
+)
+
+// Spec is the format m renders and Attach expects on the stream.
+func Spec(m *mixer.Mixer) sdl.AudioSpec {
+	return sdl.AudioSpec{Format: sdl.AUDIO_F32, Channels: mixer.OutputChannels, Freq: m.SampleRate()}
+}
+
+// Attach makes m the get callback of stream, rendering exactly as much audio
+// as SDL asks for. The stream's input format must be Spec(m).
+func Attach(m *mixer.Mixer, stream *sdl.AudioStream) error {
+	spec := Spec(m)
+	input, _, err := stream.Format()
+	if err != nil {
+		return err
+	}
+	if input != spec {
+		return fmt.Errorf("sdlmixer: stream input is %v, %d Hz, %d channels; want %v, %d Hz, %d channels",
+			input.Format, input.Freq, input.Channels, spec.Format, spec.Freq, spec.Channels)
+	}
+	// Only SDL's audio thread touches buf. unsent is set when a put failed:
+	// buf still holds audio the voices have already moved past, so it is
+	// sent again before anything new is rendered.
+	var buf []float32
+	var unsent bool
+	return stream.SetGetCallback(func(s *sdl.AudioStream, additionalAmount, totalAmount int) {
+		if unsent {
+			if s.PutFloat32(buf) != nil {
+				return
+			}
+			unsent = false
+			additionalAmount -= len(buf) * 4
+		}
+		if additionalAmount <= 0 {
+			return
+		}
+		frames := (additionalAmount + spec.FrameSize() - 1) / spec.FrameSize()
+		if cap(buf) < frames*mixer.OutputChannels {
+			buf = make([]float32, frames*mixer.OutputChannels)
+		}
+		buf = buf[:frames*mixer.OutputChannels]
+		m.Render(buf)
+		unsent = s.PutFloat32(buf) != nil
+	})
+}
+
+// Open opens device in m's format, attaches m and starts playback. Destroy
+// the returned stream to stop.
+func Open(m *mixer.Mixer, device sdl.AudioDeviceID) (*sdl.AudioStream, error) {
+	stream, err := sdl.OpenAudioStream(device, Spec(m))
+	if err != nil {
+		return nil, err
+	}
+	if err := Attach(m, stream); err != nil {
+		stream.Destroy()
+		return nil, err
+	}
+	if err := stream.Resume(); err != nil {
+		stream.Destroy()
+		return nil, err
+	}
+	return stream, nil
+}
+
+// ConvertSound converts audio in any format, such as the result of
+// sdl.LoadWAV, into a Sound at m's sample rate. Audio with more than two
+// channels is downmixed to stereo.
+func ConvertSound(m *mixer.Mixer, spec sdl.AudioSpec, data []byte) (*mixer.Sound, error) {
+	dst := sdl.AudioSpec{Format: sdl.AUDIO_F32, Channels: min(spec.Channels, 2), Freq: m.SampleRate()}
+	converted, err := sdl.ConvertAudioSamples(spec, data, dst)
+	if err != nil {
+		return nil, err
+	}
+	// AUDIO_F32 is in native byte order.
+	samples := make([]float32, len(converted)/4)
+	if len(samples) > 0 {
+		copy(unsafe.Slice((*byte)(unsafe.Pointer(&samples[0])), len(converted)), converted)
+	}
+	return mixer.NewSound(dst.Channels, samples)
+}
+
+// LoadWAV loads a WAV file as a Sound at m's sample rate.
+func LoadWAV(m *mixer.Mixer, path string) (*mixer.Sound, error) {
+	spec, data, err := sdl.LoadWAV(path)
+	if err != nil {
+		return nil, err
+	}
+	return ConvertSound(m, spec, data)
+}
@@ -0,0 +1,185 @@
+package mixer
+
+import (
+	"math"
+	"time"
+)
+
+// Voice is one playing instance of a Sound. Once it finishes or is stopped
+// it is released and its methods have no further effect.
+type Voice struct {
+	m        *Mixer
+	name     string
+	sound    *Sound
+	bus      *bus
+	pos      int // Next frame of sound to play
+	loop     bool
+	volume   float32
+	pan      float32
+	priority int
+	serial   uint64
+
+	fade       float32
+	fadeTarget float32
+	fadeStep   float32 // Per frame; 0 when not fading
+	stopping   bool    // Release when the fade reaches silence
+
+	// Channel gains at the end of the last Render, ramped towards the new
+	// targets across the next block.
+	appliedL, appliedR float32
+
+	done bool
+}
+
+func (v *Voice) Name() string {
+	return v.name
+}
+
+// Stop fades the voice out over fade and then releases it. A zero fade
+// stops it immediately.
+func (v *Voice) Stop(fade time.Duration) {
+	v.m.mu.Lock()
+	defer v.m.mu.Unlock()
+	v.stop(fade)
+}
+
+func (v *Voice) stop(fade time.Duration) {
+	if v.done {
+		return
+	}
+	frames := v.m.frames(fade)
+	if frames <= 0 || v.fade == 0 {
+		v.m.remove(v)
+		return
+	}
+	v.stopping = true
+	v.fadeTo(0, frames)
+}
+
+// Fade ramps the voice's fade level to target, between 0 and 1, over d. The
+// fade level multiplies the volume. Fading a stopping voice has no effect.
+func (v *Voice) Fade(target float32, d time.Duration) {
+	v.m.mu.Lock()
+	defer v.m.mu.Unlock()
+	if v.done || v.stopping {
+		return
+	}
+	v.fadeTo(max(0, min(1, target)), v.m.frames(d))
+}
+
+func (v *Voice) fadeTo(target float32, frames int) {
+	if frames <= 0 {
+		v.fade, v.fadeTarget, v.fadeStep = target, target, 0
+		return
+	}
+	v.fadeTarget = target
+	v.fadeStep = (target - v.fade) / float32(frames)
+}
+
+// Playing reports whether the voice is still sounding, including while it
+// fades out.
+func (v *Voice) Playing() bool {
+	v.m.mu.Lock()
+	defer v.m.mu.Unlock()
+	return !v.done
+}
+
+func (v *Voice) SetVolume(volume float32) {
+	v.m.mu.Lock()
+	defer v.m.mu.Unlock()
+	v.volume = volume
+}
+
+func (v *Voice) Volume() float32 {
+	v.m.mu.Lock()
+	defer v.m.mu.Unlock()
+	return v.volume
+}
+
+// SetPan positions the voice from -1 (left) to 1 (right). Mono sounds use a
+// constant-power pan law; stereo sounds are balanced.
+func (v *Voice) SetPan(pan float32) {
+	v.m.mu.Lock()
+	defer v.m.mu.Unlock()
+	v.pan = clampPan(pan)
+}
+
+func (v *Voice) Pan() float32 {
+	v.m.mu.Lock()
+	defer v.m.mu.Unlock()
+	return v.pan
+}
+
+// SetLoop changes whether the voice restarts at the end of its sound.
+// Turning looping off lets the current pass finish.
+func (v *Voice) SetLoop(loop bool) {
+	v.m.mu.Lock()
+	defer v.m.mu.Unlock()
+	v.loop = loop
+}
+
+func (v *Voice) Looping() bool {
+	v.m.mu.Lock()
+	defer v.m.mu.Unlock()
+	return v.loop
+}
+
+// targetGains returns the left and right gains from volume, pan and bus,
+// excluding the fade.
+func (v *Voice) targetGains() (float32, float32) {
+	gain := v.volume
+	if v.bus != nil {
+		gain *= v.bus.gain
+	}
+	if v.sound.channels == 1 {
+		angle := float64(v.pan+1) * math.Pi / 4
+		return gain * float32(math.Cos(angle)), gain * float32(math.Sin(angle))
+	}
+	return gain * min(1, 1-v.pan), gain * min(1, 1+v.pan)
+}
+
+// mix adds up to frames frames of the voice into out and marks it done when
+// its sound ends or its fade out completes.
+func (v *Voice) mix(out []float32, frames int) {
+	soundFrames := v.sound.Frames()
+	if soundFrames == 0 {
+		v.done = true
+		return
+	}
+	startL, startR := v.appliedL, v.appliedR
+	endL, endR := v.targetGains()
+	v.appliedL, v.appliedR = endL, endR
+	samples := v.sound.samples
+	for i := 0; i < frames; i++ {
+		if v.pos >= soundFrames {
+			if !v.loop {
+				v.done = true
+				return
+			}
+			v.pos = 0
+		}
+		if v.fadeStep != 0 {
+			v.fade += v.fadeStep
+			if (v.fadeStep > 0 && v.fade >= v.fadeTarget) || (v.fadeStep < 0 && v.fade <= v.fadeTarget) {
+				v.fade, v.fadeStep = v.fadeTarget, 0
+			}
+		}
+		t := float32(i+1) / float32(frames)
+		gl := (startL + (endL-startL)*t) * v.fade
+		gr := (startR + (endR-startR)*t) * v.fade
+		var l, r float32
+		if v.sound.channels == 1 {
+			l = samples[v.pos]
+			r = l
+		} else {
+			l, r = samples[2*v.pos], samples[2*v.pos+1]
+		}
+		out[2*i] += l * gl
+		out[2*i+1] += r * gr
+		v.pos++
+		if v.stopping && v.fade == 0 && v.fadeStep == 0 {
+			v.done = true
+			return
+		}
+	}
+}
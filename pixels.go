@@ -0,0 +1,291 @@
+package sdl3go
+
+/*
+#include <SDL3/SDL.h>
+
+// Bits one pixel occupies in memory, 0 for planar (FOURCC) formats.
+static int sdl3go_stored_bits_per_pixel(SDL_PixelFormat format) {
+    if (SDL_ISPIXELFORMAT_FOURCC(format)) {
+        return 0;
+    }
+    if (SDL_BYTESPERPIXEL(format) > 0) {
+        return SDL_BYTESPERPIXEL(format) * 8;
+    }
+    return SDL_BITSPERPIXEL(format);
+}
+*/
+import "C"
+
+import (
+	"fmt"
+	"strings"
+	"unsafe"
+)
+
+// PixelFormat identifies the memory layout of a pixel (SDL_PixelFormat).
+type PixelFormat uint32
+
+const (
+	PIXELFORMAT_UNKNOWN       PixelFormat = C.SDL_PIXELFORMAT_UNKNOWN
+	PIXELFORMAT_INDEX1LSB     PixelFormat = C.SDL_PIXELFORMAT_INDEX1LSB
+	PIXELFORMAT_INDEX1MSB     PixelFormat = C.SDL_PIXELFORMAT_INDEX1MSB
+	PIXELFORMAT_INDEX2LSB     PixelFormat = C.SDL_PIXELFORMAT_INDEX2LSB
+	PIXELFORMAT_INDEX2MSB     PixelFormat = C.SDL_PIXELFORMAT_INDEX2MSB
+	PIXELFORMAT_INDEX4LSB     PixelFormat = C.SDL_PIXELFORMAT_INDEX4LSB
+	PIXELFORMAT_INDEX4MSB     PixelFormat = C.SDL_PIXELFORMAT_INDEX4MSB
+	PIXELFORMAT_INDEX8        PixelFormat = C.SDL_PIXELFORMAT_INDEX8
+	PIXELFORMAT_RGB332        PixelFormat = C.SDL_PIXELFORMAT_RGB332
+	PIXELFORMAT_XRGB4444      PixelFormat = C.SDL_PIXELFORMAT_XRGB4444
+	PIXELFORMAT_XBGR4444      PixelFormat = C.SDL_PIXELFORMAT_XBGR4444
+	PIXELFORMAT_XRGB1555      PixelFormat = C.SDL_PIXELFORMAT_XRGB1555
+	PIXELFORMAT_XBGR1555      PixelFormat = C.SDL_PIXELFORMAT_XBGR1555
+	PIXELFORMAT_ARGB4444      PixelFormat = C.SDL_PIXELFORMAT_ARGB4444
+	PIXELFORMAT_RGBA4444      PixelFormat = C.SDL_PIXELFORMAT_RGBA4444
+	PIXELFORMAT_ABGR4444      PixelFormat = C.SDL_PIXELFORMAT_ABGR4444
+	PIXELFORMAT_BGRA4444      PixelFormat = C.SDL_PIXELFORMAT_BGRA4444
+	PIXELFORMAT_ARGB1555      PixelFormat = C.SDL_PIXELFORMAT_ARGB1555
+	PIXELFORMAT_RGBA5551      PixelFormat = C.SDL_PIXELFORMAT_RGBA5551
+	PIXELFORMAT_ABGR1555      PixelFormat = C.SDL_PIXELFORMAT_ABGR1555
+	PIXELFORMAT_BGRA5551      PixelFormat = C.SDL_PIXELFORMAT_BGRA5551
+	PIXELFORMAT_RGB565        PixelFormat = C.SDL_PIXELFORMAT_RGB565
+	PIXELFORMAT_BGR565        PixelFormat = C.SDL_PIXELFORMAT_BGR565
+	PIXELFORMAT_RGB24         PixelFormat = C.SDL_PIXELFORMAT_RGB24
+	PIXELFORMAT_BGR24         PixelFormat = C.SDL_PIXELFORMAT_BGR24
+	PIXELFORMAT_XRGB8888      PixelFormat = C.SDL_PIXELFORMAT_XRGB8888
+	PIXELFORMAT_RGBX8888      PixelFormat = C.SDL_PIXELFORMAT_RGBX8888
+	PIXELFORMAT_XBGR8888      PixelFormat = C.SDL_PIXELFORMAT_XBGR8888
+	PIXELFORMAT_BGRX8888      PixelFormat = C.SDL_PIXELFORMAT_BGRX8888
+	PIXELFORMAT_ARGB8888      PixelFormat = C.SDL_PIXELFORMAT_ARGB8888
+	PIXELFORMAT_RGBA8888      PixelFormat = C.SDL_PIXELFORMAT_RGBA8888
+	PIXELFORMAT_ABGR8888      PixelFormat = C.SDL_PIXELFORMAT_ABGR8888
+	PIXELFORMAT_BGRA8888      PixelFormat = C.SDL_PIXELFORMAT_BGRA8888
+	PIXELFORMAT_XRGB2101010   PixelFormat = C.SDL_PIXELFORMAT_XRGB2101010
+	PIXELFORMAT_XBGR2101010   PixelFormat = C.SDL_PIXELFORMAT_XBGR2101010
+	PIXELFORMAT_ARGB2101010   PixelFormat = C.SDL_PIXELFORMAT_ARGB2101010
+	PIXELFORMAT_ABGR2101010   PixelFormat = C.SDL_PIXELFORMAT_ABGR2101010
+	PIXELFORMAT_RGB48         PixelFormat = C.SDL_PIXELFORMAT_RGB48
+	PIXELFORMAT_BGR48         PixelFormat = C.SDL_PIXELFORMAT_BGR48
+	PIXELFORMAT_RGBA64        PixelFormat = C.SDL_PIXELFORMAT_RGBA64
+	PIXELFORMAT_ARGB64        PixelFormat = C.SDL_PIXELFORMAT_ARGB64
+	PIXELFORMAT_BGRA64        PixelFormat = C.SDL_PIXELFORMAT_BGRA64
+	PIXELFORMAT_ABGR64        PixelFormat = C.SDL_PIXELFORMAT_ABGR64
+	PIXELFORMAT_RGB48_FLOAT   PixelFormat = C.SDL_PIXELFORMAT_RGB48_FLOAT
+	PIXELFORMAT_BGR48_FLOAT   PixelFormat = C.SDL_PIXELFORMAT_BGR48_FLOAT
+	PIXELFORMAT_RGBA64_FLOAT  PixelFormat = C.SDL_PIXELFORMAT_RGBA64_FLOAT
+	PIXELFORMAT_ARGB64_FLOAT  PixelFormat = C.SDL_PIXELFORMAT_ARGB64_FLOAT
+	PIXELFORMAT_BGRA64_FLOAT  PixelFormat = C.SDL_PIXELFORMAT_BGRA64_FLOAT
+	PIXELFORMAT_ABGR64_FLOAT  PixelFormat = C.SDL_PIXELFORMAT_ABGR64_FLOAT
+	PIXELFORMAT_RGB96_FLOAT   PixelFormat = C.SDL_PIXELFORMAT_RGB96_FLOAT
+	PIXELFORMAT_BGR96_FLOAT   PixelFormat = C.SDL_PIXELFORMAT_BGR96_FLOAT
+	PIXELFORMAT_RGBA128_FLOAT PixelFormat = C.SDL_PIXELFORMAT_RGBA128_FLOAT
+	PIXELFORMAT_ARGB128_FLOAT PixelFormat = C.SDL_PIXELFORMAT_ARGB128_FLOAT
+	PIXELFORMAT_BGRA128_FLOAT PixelFormat = C.SDL_PIXELFORMAT_BGRA128_FLOAT
+	PIXELFORMAT_ABGR128_FLOAT PixelFormat = C.SDL_PIXELFORMAT_ABGR128_FLOAT
+
+	// Planar and packed YUV formats
+	PIXELFORMAT_YV12         PixelFormat = C.SDL_PIXELFORMAT_YV12
+	PIXELFORMAT_IYUV         PixelFormat = C.SDL_PIXELFORMAT_IYUV
+	PIXELFORMAT_YUY2         PixelFormat = C.SDL_PIXELFORMAT_YUY2
+	PIXELFORMAT_UYVY         PixelFormat = C.SDL_PIXELFORMAT_UYVY
+	PIXELFORMAT_YVYU         PixelFormat = C.SDL_PIXELFORMAT_YVYU
+	PIXELFORMAT_NV12         PixelFormat = C.SDL_PIXELFORMAT_NV12
+	PIXELFORMAT_NV21         PixelFormat = C.SDL_PIXELFORMAT_NV21
+	PIXELFORMAT_P010         PixelFormat = C.SDL_PIXELFORMAT_P010
+	PIXELFORMAT_EXTERNAL_OES PixelFormat = C.SDL_PIXELFORMAT_EXTERNAL_OES
+
+	// Byte-order aliases: RGBA32 is R, G, B, A in memory on every platform.
+	PIXELFORMAT_RGBA32 PixelFormat = C.SDL_PIXELFORMAT_RGBA32
+	PIXELFORMAT_ARGB32 PixelFormat = C.SDL_PIXELFORMAT_ARGB32
+	PIXELFORMAT_BGRA32 PixelFormat = C.SDL_PIXELFORMAT_BGRA32
+	PIXELFORMAT_ABGR32 PixelFormat = C.SDL_PIXELFORMAT_ABGR32
+	PIXELFORMAT_RGBX32 PixelFormat = C.SDL_PIXELFORMAT_RGBX32
+	PIXELFORMAT_XRGB32 PixelFormat = C.SDL_PIXELFORMAT_XRGB32
+	PIXELFORMAT_BGRX32 PixelFormat = C.SDL_PIXELFORMAT_BGRX32
+	PIXELFORMAT_XBGR32 PixelFormat = C.SDL_PIXELFORMAT_XBGR32
+)
+
+// String returns the constant name without the package prefix, e.g.
+// "PIXELFORMAT_RGBA8888". Byte-order aliases print as the format they
+// resolve to on this platform.
+func (f PixelFormat) String() string {
+	name := C.GoString(C.SDL_GetPixelFormatName(C.SDL_PixelFormat(f)))
+	return strings.TrimPrefix(name, "SDL_")
+}
+
+// PixelFormatDetails mirrors SDL_PixelFormatDetails. Masks, bit counts and
+// shifts are zero for indexed and planar formats.
+type PixelFormatDetails struct {
+	Format        PixelFormat
+	BitsPerPixel  int
+	BytesPerPixel int
+
+	RMask, GMask, BMask, AMask     uint32
+	RBits, GBits, BBits, ABits     int
+	RShift, GShift, BShift, AShift int
+}
+
+// Details describes how a pixel of this format is packed.
+func (f PixelFormat) Details() (PixelFormatDetails, error) {
+	details := C.SDL_GetPixelFormatDetails(C.SDL_PixelFormat(f))
+	if details == nil {
+		return PixelFormatDetails{}, GetError()
+	}
+	return PixelFormatDetails{
+		Format:        PixelFormat(details.format),
+		BitsPerPixel:  int(details.bits_per_pixel),
+		BytesPerPixel: int(details.bytes_per_pixel),
+		RMask:         uint32(details.Rmask),
+		GMask:         uint32(details.Gmask),
+		BMask:         uint32(details.Bmask),
+		AMask:         uint32(details.Amask),
+		RBits:         int(details.Rbits),
+		GBits:         int(details.Gbits),
+		BBits:         int(details.Bbits),
+		ABits:         int(details.Abits),
+		RShift:        int(details.Rshift),
+		GShift:        int(details.Gshift),
+		BShift:        int(details.Bshift),
+		AShift:        int(details.Ashift),
+	}, nil
+}
+
+// MapRGBA packs a color into a pixel value of format, for use with calls such
+// as FillSurfaceRect. Formats without alpha ignore a. Indexed formats need a
+// palette; use Surface.MapRGBA for those.
+func MapRGBA(format PixelFormat, r, g, b, a uint8) uint32 {
+	details := C.SDL_GetPixelFormatDetails(C.SDL_PixelFormat(format))
+	if details == nil {
+		return 0
+	}
+	return uint32(C.SDL_MapRGBA(details, nil, C.Uint8(r), C.Uint8(g), C.Uint8(b), C.Uint8(a)))
+}
+
+// GetRGBA unpacks a pixel value of format. Formats without alpha report 255.
+func GetRGBA(pixel uint32, format PixelFormat) (r, g, b, a uint8) {
+	details := C.SDL_GetPixelFormatDetails(C.SDL_PixelFormat(format))
+	if details == nil {
+		return 0, 0, 0, 0
+	}
+	var cr, cg, cb, ca C.Uint8
+	C.SDL_GetRGBA(C.Uint32(pixel), details, nil, &cr, &cg, &cb, &ca)
+	return uint8(cr), uint8(cg), uint8(cb), uint8(ca)
+}
+
+// MapRGBA packs a color into a pixel value of the surface's format, using its
+// palette for indexed formats.
+func (s *Surface) MapRGBA(r, g, b, a uint8) uint32 {
+	if s == nil || s.handle == nil {
+		return 0
+	}
+	return uint32(C.SDL_MapSurfaceRGBA(s.handle, C.Uint8(r), C.Uint8(g), C.Uint8(b), C.Uint8(a)))
+}
+
+// ConvertPixels converts width x height pixels from src to dst. Both buffers
+// are rows spaced by their pitch in bytes and must not overlap. Only packed
+// formats are supported.
+func ConvertPixels(width, height int, srcFormat PixelFormat, src []byte, srcPitch int, dstFormat PixelFormat, dst []byte, dstPitch int) error {
+	srcPtr, dstPtr, err := pixelConversionBuffers(width, height, srcFormat, src, srcPitch, dstFormat, dst, dstPitch)
+	if err != nil || srcPtr == nil {
+		return err
+	}
+	if !C.SDL_ConvertPixels(C.int(width), C.int(height), C.SDL_PixelFormat(srcFormat), srcPtr, C.int(srcPitch),
+		C.SDL_PixelFormat(dstFormat), dstPtr, C.int(dstPitch)) {
+		return GetError()
+	}
+	return nil
+}
+
+// ConvertPixelsAndColorspace is ConvertPixels with colorspace conversion,
+// e.g. from COLORSPACE_HDR10 to COLORSPACE_SRGB.
+func ConvertPixelsAndColorspace(width, height int, srcFormat PixelFormat, srcColorspace Colorspace, src []byte, srcPitch int,
+	dstFormat PixelFormat, dstColorspace Colorspace, dst []byte, dstPitch int) error {
+	srcPtr, dstPtr, err := pixelConversionBuffers(width, height, srcFormat, src, srcPitch, dstFormat, dst, dstPitch)
+	if err != nil || srcPtr == nil {
+		return err
+	}
+	if !C.SDL_ConvertPixelsAndColorspace(C.int(width), C.int(height),
+		C.SDL_PixelFormat(srcFormat), C.SDL_Colorspace(srcColorspace), 0, srcPtr, C.int(srcPitch),
+		C.SDL_PixelFormat(dstFormat), C.SDL_Colorspace(dstColorspace), 0, dstPtr, C.int(dstPitch)) {
+		return GetError()
+	}
+	return nil
+}
+
+// PremultiplyAlpha converts width x height straight-alpha pixels from src to
+// premultiplied pixels in dst. src and dst may be the same buffer. With
+// linear set the multiplication happens in linear light, which is more
+// accurate for sRGB content.
+func PremultiplyAlpha(width, height int, srcFormat PixelFormat, src []byte, srcPitch int, dstFormat PixelFormat, dst []byte, dstPitch int, linear bool) error {
+	srcPtr, dstPtr, err := pixelConversionBuffers(width, height, srcFormat, src, srcPitch, dstFormat, dst, dstPitch)
+	if err != nil || srcPtr == nil {
+		return err
+	}
+	if !C.SDL_PremultiplyAlpha(C.int(width), C.int(height), C.SDL_PixelFormat(srcFormat), srcPtr, C.int(srcPitch),
+		C.SDL_PixelFormat(dstFormat), dstPtr, C.int(dstPitch), C.bool(linear)) {
+		return GetError()
+	}
+	return nil
+}
+
+// PremultiplyAlpha converts the surface's pixels to premultiplied alpha in
+// place.
+func (s *Surface) PremultiplyAlpha(linear bool) error {
+	if s == nil || s.handle == nil {
+		return fmt.Errorf("surface is destroyed")
+	}
+	if !C.SDL_PremultiplySurfaceAlpha(s.handle, C.bool(linear)) {
+		return GetError()
+	}
+	return nil
+}
+
+// pixelConversionBuffers checks that src and dst are large enough for the
+// conversion and returns their base pointers, or nil pointers if there is
+// nothing to convert.
+func pixelConversionBuffers(width, height int, srcFormat PixelFormat, src []byte, srcPitch int, dstFormat PixelFormat, dst []byte, dstPitch int) (unsafe.Pointer, unsafe.Pointer, error) {
+	srcSize, err := pixelBufferSize(srcFormat, width, height, srcPitch)
+	if err != nil {
+		return nil, nil, err
+	}
+	dstSize, err := pixelBufferSize(dstFormat, width, height, dstPitch)
+	if err != nil {
+		return nil, nil, err
+	}
+	if srcSize == 0 || dstSize == 0 {
+		return nil, nil, nil
+	}
+	if len(src) < srcSize {
+		return nil, nil, fmt.Errorf("source buffer holds %d bytes, need %d", len(src), srcSize)
+	}
+	if len(dst) < dstSize {
+		return nil, nil, fmt.Errorf("destination buffer holds %d bytes, need %d", len(dst), dstSize)
+	}
+	return unsafe.Pointer(&src[0]), unsafe.Pointer(&dst[0]), nil
+}
+
+// Color mirrors SDL_Color.
+type Color struct {
+	R, G, B, A uint8
+}
+
+// FColor mirrors SDL_FColor. Components are normally 0..1.
+type FColor struct {
+	R, G, B, A float32
+}
+
+// pixelBufferSize returns the minimum number of bytes holding height rows of
+// width pixels spaced pitch bytes apart. The last row need not be padded.
+func pixelBufferSize(format PixelFormat, width, height, pitch int) (int, error) {
+	bits := int(C.sdl3go_stored_bits_per_pixel(C.SDL_PixelFormat(format)))
+	if bits == 0 {
+		return 0, fmt.Errorf("pixel format %v is not supported", format)
+	}
+	if width <= 0 || height <= 0 {
+		return 0, nil
+	}
+	rowBytes := (width*bits + 7) / 8
+	if pitch < rowBytes {
+		return 0, fmt.Errorf("pitch %d is less than a row of %d pixels", pitch, width)
+	}
+	return (height-1)*pitch + rowBytes, nil
+}
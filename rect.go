@@ -0,0 +1,43 @@
+package sdl3go
+
+/*
+#include <SDL3/SDL.h>
+*/
+import "C"
+
+import "unsafe"
+
+// The types below match the memory layout of their SDL counterparts so that
+// slices of them can be handed to SDL without copying.
+
+// Point mirrors SDL_Point.
+type Point struct {
+	X, Y int32
+}
+
+// FPoint mirrors SDL_FPoint.
+type FPoint struct {
+	X, Y float32
+}
+
+// Rect mirrors SDL_Rect.
+type Rect struct {
+	X, Y, W, H int32
+}
+
+// FRect mirrors SDL_FRect.
+type FRect struct {
+	X, Y, W, H float32
+}
+
+func (r *Rect) cptr() *C.SDL_Rect {
+	return (*C.SDL_Rect)(unsafe.Pointer(r))
+}
+
+func (r *FRect) cptr() *C.SDL_FRect {
+	return (*C.SDL_FRect)(unsafe.Pointer(r))
+}
+
+func (p *FPoint) cptr() *C.SDL_FPoint {
+	return (*C.SDL_FPoint)(unsafe.Pointer(p))
+}
@@ -0,0 +1,203 @@
+package sdl3go
+
+/*
+#include <stdlib.h>
+#include <SDL3/SDL.h>
+*/
+import "C"
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+type Renderer struct {
+	handle *C.SDL_Renderer
+}
+
+// Texture is owned by the renderer that created it. Destroying the renderer
+// destroys its textures, so Destroy them first.
+type Texture struct {
+	handle *C.SDL_Texture
+}
+
+type TextureAccess int
+
+const (
+	TEXTUREACCESS_STATIC    TextureAccess = C.SDL_TEXTUREACCESS_STATIC
+	TEXTUREACCESS_STREAMING TextureAccess = C.SDL_TEXTUREACCESS_STREAMING
+	TEXTUREACCESS_TARGET    TextureAccess = C.SDL_TEXTUREACCESS_TARGET
+)
+
+// VSync values for SetVSync. Positive values present every Nth refresh.
+const (
+	RENDERER_VSYNC_DISABLED = 0
+	RENDERER_VSYNC_ADAPTIVE = -1
+)
+
+// Renderer driver name used by CreateRenderer for the CPU rasterizer.
+const SOFTWARE_RENDERER = "software"
+
+// RenderDrivers lists the renderer backends compiled into SDL, in the order
+// SDL tries them.
+func RenderDrivers() []string {
+	count := int(C.SDL_GetNumRenderDrivers())
+	drivers := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		if name := C.SDL_GetRenderDriver(C.int(i)); name != nil {
+			drivers = append(drivers, C.GoString(name))
+		}
+	}
+	return drivers
+}
+
+// CreateRenderer creates a renderer for window. An empty driverName lets SDL
+// pick the best available backend.
+func CreateRenderer(window *Window, driverName string) (*Renderer, error) {
+	if window == nil || window.handle == nil {
+		return nil, fmt.Errorf("window is destroyed")
+	}
+	var cName *C.char
+	if driverName != "" {
+		cName = C.CString(driverName)
+		defer C.free(unsafe.Pointer(cName))
+	}
+	handle := C.SDL_CreateRenderer(window.handle, cName)
+	if handle == nil {
+		return nil, GetError()
+	}
+	return &Renderer{handle: handle}, nil
+}
+
+// CreateSoftwareRenderer renders into surface on the CPU. It needs no window
+// or GPU, which makes it usable under the dummy video driver.
+func CreateSoftwareRenderer(surface *Surface) (*Renderer, error) {
+	if surface == nil || surface.handle == nil {
+		return nil, fmt.Errorf("surface is destroyed")
+	}
+	handle := C.SDL_CreateSoftwareRenderer(surface.handle)
+	if handle == nil {
+		return nil, GetError()
+	}
+	return &Renderer{handle: handle}, nil
+}
+
+func (r *Renderer) Name() string {
+	if r == nil || r.handle == nil {
+		return ""
+	}
+	name := C.SDL_GetRendererName(r.handle)
+	if name == nil {
+		return ""
+	}
+	return C.GoString(name)
+}
+
+func (r *Renderer) OutputSize() (int, int, error) {
+	if r == nil || r.handle == nil {
+		return 0, 0, fmt.Errorf("renderer is destroyed")
+	}
+	var width C.int
+	var height C.int
+	if !C.SDL_GetRenderOutputSize(r.handle, &width, &height) {
+		return 0, 0, GetError()
+	}
+	return int(width), int(height), nil
+}
+
+func (r *Renderer) SetDrawColor(red, green, blue, alpha uint8) error {
+	if r == nil || r.handle == nil {
+		return fmt.Errorf("renderer is destroyed")
+	}
+	if !C.SDL_SetRenderDrawColor(r.handle, C.Uint8(red), C.Uint8(green), C.Uint8(blue), C.Uint8(alpha)) {
+		return GetError()
+	}
+	return nil
+}
+
+// SetDrawColorFloat sets the draw color with components in 0..1. Values above
+// 1 are kept and scaled by the color scale, which matters for HDR output.
+func (r *Renderer) SetDrawColorFloat(red, green, blue, alpha float32) error {
+	if r == nil || r.handle == nil {
+		return fmt.Errorf("renderer is destroyed")
+	}
+	if !C.SDL_SetRenderDrawColorFloat(r.handle, C.float(red), C.float(green), C.float(blue), C.float(alpha)) {
+		return GetError()
+	}
+	return nil
+}
+
+// Clear fills the current render target with the draw color, ignoring the
+// viewport and clip rectangle.
+func (r *Renderer) Clear() error {
+	if r == nil || r.handle == nil {
+		return fmt.Errorf("renderer is destroyed")
+	}
+	if !C.SDL_RenderClear(r.handle) {
+		return GetError()
+	}
+	return nil
+}
+
+func (r *Renderer) Present() error {
+	if r == nil || r.handle == nil {
+		return fmt.Errorf("renderer is destroyed")
+	}
+	if !C.SDL_RenderPresent(r.handle) {
+		return GetError()
+	}
+	return nil
+}
+
+// SetVSync takes RENDERER_VSYNC_DISABLED, RENDERER_VSYNC_ADAPTIVE or a
+// positive refresh interval.
+func (r *Renderer) SetVSync(vsync int) error {
+	if r == nil || r.handle == nil {
+		return fmt.Errorf("renderer is destroyed")
+	}
+	if !C.SDL_SetRenderVSync(r.handle, C.int(vsync)) {
+		return GetError()
+	}
+	return nil
+}
+
+func (r *Renderer) VSync() (int, error) {
+	if r == nil || r.handle == nil {
+		return 0, fmt.Errorf("renderer is destroyed")
+	}
+	var vsync C.int
+	if !C.SDL_GetRenderVSync(r.handle, &vsync) {
+		return 0, GetError()
+	}
+	return int(vsync), nil
+}
+
+func (r *Renderer) Destroy() {
+	if r == nil || r.handle == nil {
+		return
+	}
+	C.SDL_DestroyRenderer(r.handle)
+	r.handle = nil
+}
+
+func CreateTexture(renderer *Renderer, format PixelFormat, access TextureAccess, width, height int) (*Texture, error) {
+	if renderer == nil || renderer.handle == nil {
+		return nil, fmt.Errorf("renderer is destroyed")
+	}
+	if width <= 0 || height <= 0 {
+		return nil, fmt.Errorf("invalid texture size %dx%d", width, height)
+	}
+	handle := C.SDL_CreateTexture(renderer.handle, C.SDL_PixelFormat(format), C.SDL_TextureAccess(access), C.int(width), C.int(height))
+	if handle == nil {
+		return nil, GetError()
+	}
+	return &Texture{handle: handle}, nil
+}
+
+func (t *Texture) Destroy() {
+	if t == nil || t.handle == nil {
+		return
+	}
+	C.SDL_DestroyTexture(t.handle)
+	t.handle = nil
+}
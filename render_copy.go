@@ -0,0 +1,87 @@
+package sdl3go
+
+/*
+#include <SDL3/SDL.h>
+*/
+import "C"
+
+import "fmt"
+
+// In the calls below a nil src uses the whole texture and a nil dst fills the
+// whole render target.
+
+func (r *Renderer) RenderTexture(texture *Texture, src, dst *FRect) error {
+	if r == nil || r.handle == nil {
+		return fmt.Errorf("renderer is destroyed")
+	}
+	if texture == nil || texture.handle == nil {
+		return fmt.Errorf("texture is destroyed")
+	}
+	if !C.SDL_RenderTexture(r.handle, texture.handle, src.cptr(), dst.cptr()) {
+		return GetError()
+	}
+	return nil
+}
+
+// RenderTextureRotated draws src into dst rotated clockwise by angle degrees
+// around center, which is relative to dst. A nil center rotates around the
+// middle of dst.
+func (r *Renderer) RenderTextureRotated(texture *Texture, src, dst *FRect, angle float64, center *FPoint, flip FlipMode) error {
+	if r == nil || r.handle == nil {
+		return fmt.Errorf("renderer is destroyed")
+	}
+	if texture == nil || texture.handle == nil {
+		return fmt.Errorf("texture is destroyed")
+	}
+	if !C.SDL_RenderTextureRotated(r.handle, texture.handle, src.cptr(), dst.cptr(), C.double(angle), center.cptr(), C.SDL_FlipMode(flip)) {
+		return GetError()
+	}
+	return nil
+}
+
+// RenderTextureTiled repeats src across dst, each tile scaled by scale.
+func (r *Renderer) RenderTextureTiled(texture *Texture, src *FRect, scale float32, dst *FRect) error {
+	if r == nil || r.handle == nil {
+		return fmt.Errorf("renderer is destroyed")
+	}
+	if texture == nil || texture.handle == nil {
+		return fmt.Errorf("texture is destroyed")
+	}
+	if !C.SDL_RenderTextureTiled(r.handle, texture.handle, src.cptr(), C.float(scale), dst.cptr()) {
+		return GetError()
+	}
+	return nil
+}
+
+// RenderTexture9Grid draws src as a 9-slice: the corners, sized by the four
+// border widths in texture pixels, are drawn at scale; the edges stretch
+// along one axis and the center along both to fill dst.
+func (r *Renderer) RenderTexture9Grid(texture *Texture, src *FRect, leftWidth, rightWidth, topHeight, bottomHeight, scale float32, dst *FRect) error {
+	if r == nil || r.handle == nil {
+		return fmt.Errorf("renderer is destroyed")
+	}
+	if texture == nil || texture.handle == nil {
+		return fmt.Errorf("texture is destroyed")
+	}
+	if !C.SDL_RenderTexture9Grid(r.handle, texture.handle, src.cptr(), C.float(leftWidth), C.float(rightWidth),
+		C.float(topHeight), C.float(bottomHeight), C.float(scale), dst.cptr()) {
+		return GetError()
+	}
+	return nil
+}
+
+// RenderTextureAffine maps the top-left, top-right and bottom-left corners of
+// src to origin, right and down, which allows skewing as well as rotation.
+// A nil point defaults to the matching corner of the render target.
+func (r *Renderer) RenderTextureAffine(texture *Texture, src *FRect, origin, right, down *FPoint) error {
+	if r == nil || r.handle == nil {
+		return fmt.Errorf("renderer is destroyed")
+	}
+	if texture == nil || texture.handle == nil {
+		return fmt.Errorf("texture is destroyed")
+	}
+	if !C.SDL_RenderTextureAffine(r.handle, texture.handle, src.cptr(), origin.cptr(), right.cptr(), down.cptr()) {
+		return GetError()
+	}
+	return nil
+}
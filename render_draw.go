@@ -0,0 +1,216 @@
+package sdl3go
+
+/*
+#include <stdlib.h>
+#include <SDL3/SDL.h>
+*/
+import "C"
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// Vertex mirrors SDL_Vertex. TexCoord is normalized (0..1) and ignored when
+// RenderGeometry is called without a texture.
+type Vertex struct {
+	Position FPoint
+	Color    FColor
+	TexCoord FPoint
+}
+
+func (r *Renderer) RenderPoint(x, y float32) error {
+	if r == nil || r.handle == nil {
+		return fmt.Errorf("renderer is destroyed")
+	}
+	if !C.SDL_RenderPoint(r.handle, C.float(x), C.float(y)) {
+		return GetError()
+	}
+	return nil
+}
+
+func (r *Renderer) RenderPoints(points []FPoint) error {
+	if r == nil || r.handle == nil {
+		return fmt.Errorf("renderer is destroyed")
+	}
+	if len(points) == 0 {
+		return nil
+	}
+	if !C.SDL_RenderPoints(r.handle, points[0].cptr(), C.int(len(points))) {
+		return GetError()
+	}
+	return nil
+}
+
+func (r *Renderer) RenderLine(x1, y1, x2, y2 float32) error {
+	if r == nil || r.handle == nil {
+		return fmt.Errorf("renderer is destroyed")
+	}
+	if !C.SDL_RenderLine(r.handle, C.float(x1), C.float(y1), C.float(x2), C.float(y2)) {
+		return GetError()
+	}
+	return nil
+}
+
+// RenderLines draws a connected polyline through points.
+func (r *Renderer) RenderLines(points []FPoint) error {
+	if r == nil || r.handle == nil {
+		return fmt.Errorf("renderer is destroyed")
+	}
+	if len(points) == 0 {
+		return nil
+	}
+	if !C.SDL_RenderLines(r.handle, points[0].cptr(), C.int(len(points))) {
+		return GetError()
+	}
+	return nil
+}
+
+// RenderRect outlines rect, or the whole render target if rect is nil.
+func (r *Renderer) RenderRect(rect *FRect) error {
+	if r == nil || r.handle == nil {
+		return fmt.Errorf("renderer is destroyed")
+	}
+	if !C.SDL_RenderRect(r.handle, rect.cptr()) {
+		return GetError()
+	}
+	return nil
+}
+
+func (r *Renderer) RenderRects(rects []FRect) error {
+	if r == nil || r.handle == nil {
+		return fmt.Errorf("renderer is destroyed")
+	}
+	if len(rects) == 0 {
+		return nil
+	}
+	if !C.SDL_RenderRects(r.handle, rects[0].cptr(), C.int(len(rects))) {
+		return GetError()
+	}
+	return nil
+}
+
+// RenderFillRect fills rect, or the whole render target if rect is nil.
+func (r *Renderer) RenderFillRect(rect *FRect) error {
+	if r == nil || r.handle == nil {
+		return fmt.Errorf("renderer is destroyed")
+	}
+	if !C.SDL_RenderFillRect(r.handle, rect.cptr()) {
+		return GetError()
+	}
+	return nil
+}
+
+func (r *Renderer) RenderFillRects(rects []FRect) error {
+	if r == nil || r.handle == nil {
+		return fmt.Errorf("renderer is destroyed")
+	}
+	if len(rects) == 0 {
+		return nil
+	}
+	if !C.SDL_RenderFillRects(r.handle, rects[0].cptr(), C.int(len(rects))) {
+		return GetError()
+	}
+	return nil
+}
+
+// RenderGeometry draws triangles from vertices. With indices nil, every three
+// consecutive vertices form a triangle; otherwise each index triple does.
+// texture may be nil for untextured geometry. Both slices are passed to SDL
+// in place.
+func (r *Renderer) RenderGeometry(texture *Texture, vertices []Vertex, indices []int32) error {
+	if r == nil || r.handle == nil {
+		return fmt.Errorf("renderer is destroyed")
+	}
+	if len(vertices) == 0 {
+		return nil
+	}
+	var cIndices *C.int
+	if len(indices) > 0 {
+		cIndices = (*C.int)(unsafe.Pointer(&indices[0]))
+	}
+	if !C.SDL_RenderGeometry(r.handle, texture.chandle(), (*C.SDL_Vertex)(unsafe.Pointer(&vertices[0])), C.int(len(vertices)),
+		cIndices, C.int(len(indices))) {
+		return GetError()
+	}
+	return nil
+}
+
+// RenderGeometryRaw draws triangles from separate, possibly interleaved
+// attribute arrays. Strides are in bytes between consecutive vertices: xy and
+// uv hold float pairs, colors holds one FColor per vertex. uv may be nil when
+// texture is nil. indices follows the same rules as RenderGeometry.
+func (r *Renderer) RenderGeometryRaw(texture *Texture, xy []float32, xyStride int, colors []FColor, colorStride int,
+	uv []float32, uvStride int, numVertices int, indices []int32) error {
+	if r == nil || r.handle == nil {
+		return fmt.Errorf("renderer is destroyed")
+	}
+	if numVertices <= 0 {
+		return nil
+	}
+	if !strideFits(len(xy)*4, xyStride, 8, numVertices) {
+		return fmt.Errorf("xy holds fewer than %d vertices at stride %d", numVertices, xyStride)
+	}
+	if !strideFits(len(colors)*16, colorStride, 16, numVertices) {
+		return fmt.Errorf("colors holds fewer than %d vertices at stride %d", numVertices, colorStride)
+	}
+	var cUV *C.float
+	if uv != nil {
+		if !strideFits(len(uv)*4, uvStride, 8, numVertices) {
+			return fmt.Errorf("uv holds fewer than %d vertices at stride %d", numVertices, uvStride)
+		}
+		cUV = (*C.float)(unsafe.Pointer(&uv[0]))
+	} else if texture != nil {
+		return fmt.Errorf("textured geometry needs uv coordinates")
+	}
+	var cIndices unsafe.Pointer
+	if len(indices) > 0 {
+		cIndices = unsafe.Pointer(&indices[0])
+	}
+	if !C.SDL_RenderGeometryRaw(r.handle, texture.chandle(),
+		(*C.float)(unsafe.Pointer(&xy[0])), C.int(xyStride),
+		(*C.SDL_FColor)(unsafe.Pointer(&colors[0])), C.int(colorStride),
+		cUV, C.int(uvStride), C.int(numVertices), cIndices, C.int(len(indices)), 4) {
+		return GetError()
+	}
+	return nil
+}
+
+// strideFits reports whether a buffer of size bytes holds count elements of
+// elemSize bytes spaced stride bytes apart.
+func strideFits(size, stride, elemSize, count int) bool {
+	if stride < 0 || size < elemSize {
+		return false
+	}
+	return (count-1)*stride+elemSize <= size
+}
+
+func (t *Texture) chandle() *C.SDL_Texture {
+	if t == nil {
+		return nil
+	}
+	return t.handle
+}
+
+// DEBUG_TEXT_FONT_CHARACTER_SIZE is the width and height in pixels of one
+// glyph drawn by DebugText, before render scaling.
+const DEBUG_TEXT_FONT_CHARACTER_SIZE = C.SDL_DEBUG_TEXT_FONT_CHARACTER_SIZE
+
+// DebugText draws text with SDL's built-in 8x8 bitmap font in the current
+// draw color, with its top-left corner at x, y. It only covers ASCII and is
+// meant for overlays such as FPS counters, not for user-facing text.
+func (r *Renderer) DebugText(x, y float32, text string) error {
+	if r == nil || r.handle == nil {
+		return fmt.Errorf("renderer is destroyed")
+	}
+	cText := C.CString(text)
+	defer C.free(unsafe.Pointer(cText))
+	if !C.SDL_RenderDebugText(r.handle, C.float(x), C.float(y), cText) {
+		return GetError()
+	}
+	return nil
+}
+
+func (r *Renderer) DebugTextf(x, y float32, format string, args ...any) error {
+	return r.DebugText(x, y, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,49 @@
+package sdl3go
+
+/*
+#include <SDL3/SDL.h>
+*/
+import "C"
+
+import (
+	"fmt"
+	"image"
+)
+
+// ReadPixels copies rect of the current render target, or the whole viewport
+// if rect is nil, into a new image. The pixels are converted to RGBA32 and
+// copied row by row, so the result does not depend on the target's format or
+// pitch. SDL stores straight alpha, so the pixels are premultiplied as
+// image.RGBA requires.
+//
+// Call it before Present, since the back buffer is undefined afterwards. It
+// is slow on GPU renderers and meant for screenshots and tests, e.g. with
+// CreateSoftwareRenderer under SDL_VIDEODRIVER=dummy.
+func (r *Renderer) ReadPixels(rect *Rect) (*image.RGBA, error) {
+	if r == nil || r.handle == nil {
+		return nil, fmt.Errorf("renderer is destroyed")
+	}
+	surface := C.SDL_RenderReadPixels(r.handle, rect.cptr())
+	if surface == nil {
+		return nil, GetError()
+	}
+	defer C.SDL_DestroySurface(surface)
+
+	converted := surface
+	if surface.format != C.SDL_PIXELFORMAT_RGBA32 {
+		converted = C.SDL_ConvertSurface(surface, C.SDL_PIXELFORMAT_RGBA32)
+		if converted == nil {
+			return nil, GetError()
+		}
+		defer C.SDL_DestroySurface(converted)
+	}
+	// Both surfaces belong to this call, so premultiplying in place is safe.
+	if !C.SDL_PremultiplySurfaceAlpha(converted, false) {
+		return nil, GetError()
+	}
+	img := image.NewRGBA(image.Rect(0, 0, int(converted.w), int(converted.h)))
+	if err := copySurfacePixels(converted, img.Pix, img.Stride); err != nil {
+		return nil, err
+	}
+	return img, nil
+}
@@ -0,0 +1,50 @@
+package sdl3go
+
+import (
+	"image/color"
+	"path/filepath"
+	"testing"
+)
+
+// The software renderer draws into a plain surface, so this needs neither a
+// window nor a GPU.
+func TestReadPixelsGoldenRoundTrip(t *testing.T) {
+	surface, err := CreateSurface(4, 4, PIXELFORMAT_RGBA32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer surface.Destroy()
+	renderer, err := CreateSoftwareRenderer(surface)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer renderer.Destroy()
+
+	if err := renderer.SetDrawColor(255, 0, 0, 128); err != nil {
+		t.Fatal(err)
+	}
+	if err := renderer.Clear(); err != nil {
+		t.Fatal(err)
+	}
+	img, err := renderer.ReadPixels(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := img.RGBAAt(1, 1)
+	if got.R > got.A || got.A != 128 {
+		t.Fatalf("ReadPixels returned %v, want premultiplied alpha 128", got)
+	}
+	straight := color.NRGBAModel.Convert(got).(color.NRGBA)
+	if straight.R < 254 || straight.G != 0 || straight.B != 0 {
+		t.Errorf("ReadPixels returned %v, which is %v unpremultiplied; want about {255 0 0 128}", got, straight)
+	}
+
+	path := filepath.Join(t.TempDir(), "translucent.png")
+	if err := SaveGolden(path, img); err != nil {
+		t.Fatal(err)
+	}
+	if err := CompareGolden(path, img, 1); err != nil {
+		t.Errorf("CompareGolden against its own output: %v", err)
+	}
+}
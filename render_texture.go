@@ -0,0 +1,229 @@
+package sdl3go
+
+/*
+#include <SDL3/SDL.h>
+*/
+import "C"
+
+import (
+	"fmt"
+	"image"
+	"unsafe"
+)
+
+// CreateTextureFromImage uploads img into a new static RGBA32 texture. The
+// texture's blend mode is set to match img's alpha: premultiplied for
+// *image.RGBA and *image.RGBA64, straight for everything else.
+func CreateTextureFromImage(renderer *Renderer, img image.Image) (*Texture, error) {
+	bounds := img.Bounds()
+	texture, err := CreateTexture(renderer, PIXELFORMAT_RGBA32, TEXTUREACCESS_STATIC, bounds.Dx(), bounds.Dy())
+	if err != nil {
+		return nil, err
+	}
+	pix, stride, premultiplied := imagePixels(img)
+	if err := texture.Update(nil, pix, stride); err != nil {
+		texture.Destroy()
+		return nil, err
+	}
+	blendMode := BLENDMODE_BLEND
+	if premultiplied {
+		blendMode = BLENDMODE_BLEND_PREMULTIPLIED
+	}
+	if err := texture.SetBlendMode(blendMode); err != nil {
+		texture.Destroy()
+		return nil, err
+	}
+	return texture, nil
+}
+
+func (t *Texture) Size() (int, int) {
+	if t == nil || t.handle == nil {
+		return 0, 0
+	}
+	return int(t.handle.w), int(t.handle.h)
+}
+
+func (t *Texture) Format() PixelFormat {
+	if t == nil || t.handle == nil {
+		return PIXELFORMAT_UNKNOWN
+	}
+	return PixelFormat(t.handle.format)
+}
+
+// regionBytes returns the minimum buffer size for rect (or the whole
+// texture if rect is nil) at the given pitch.
+func (t *Texture) regionBytes(rect *Rect, pitch int) (int, error) {
+	width, height := t.Size()
+	if rect != nil {
+		width, height = int(rect.W), int(rect.H)
+	}
+	return pixelBufferSize(t.Format(), width, height, pitch)
+}
+
+// Update replaces the pixels in rect, or the whole texture if rect is nil,
+// with rows of pixels spaced pitch bytes apart in the texture's format.
+// Streaming textures are usually faster to fill through Lock.
+func (t *Texture) Update(rect *Rect, pixels []byte, pitch int) error {
+	if t == nil || t.handle == nil {
+		return fmt.Errorf("texture is destroyed")
+	}
+	need, err := t.regionBytes(rect, pitch)
+	if err != nil {
+		return err
+	}
+	if need == 0 {
+		return nil
+	}
+	if len(pixels) < need {
+		return fmt.Errorf("pixel buffer holds %d bytes, need %d", len(pixels), need)
+	}
+	if !C.SDL_UpdateTexture(t.handle, rect.cptr(), unsafe.Pointer(&pixels[0]), C.int(pitch)) {
+		return GetError()
+	}
+	return nil
+}
+
+// Lock maps rect, or the whole texture if rect is nil, of a streaming texture
+// for writing. The returned slice aliases SDL-owned memory, is write-only
+// (its previous contents are undefined) and must not be used after Unlock.
+func (t *Texture) Lock(rect *Rect) ([]byte, int, error) {
+	if t == nil || t.handle == nil {
+		return nil, 0, fmt.Errorf("texture is destroyed")
+	}
+	var pixels unsafe.Pointer
+	var pitch C.int
+	if !C.SDL_LockTexture(t.handle, rect.cptr(), &pixels, &pitch) {
+		return nil, 0, GetError()
+	}
+	size, err := t.regionBytes(rect, int(pitch))
+	if err != nil {
+		C.SDL_UnlockTexture(t.handle)
+		return nil, 0, err
+	}
+	return unsafe.Slice((*byte)(pixels), size), int(pitch), nil
+}
+
+// Unlock uploads the changes made since Lock.
+func (t *Texture) Unlock() {
+	if t == nil || t.handle == nil {
+		return
+	}
+	C.SDL_UnlockTexture(t.handle)
+}
+
+// SetColorMod multiplies each color channel by c/255 when the texture is
+// drawn.
+func (t *Texture) SetColorMod(red, green, blue uint8) error {
+	if t == nil || t.handle == nil {
+		return fmt.Errorf("texture is destroyed")
+	}
+	if !C.SDL_SetTextureColorMod(t.handle, C.Uint8(red), C.Uint8(green), C.Uint8(blue)) {
+		return GetError()
+	}
+	return nil
+}
+
+func (t *Texture) ColorMod() (uint8, uint8, uint8, error) {
+	if t == nil || t.handle == nil {
+		return 0, 0, 0, fmt.Errorf("texture is destroyed")
+	}
+	var red, green, blue C.Uint8
+	if !C.SDL_GetTextureColorMod(t.handle, &red, &green, &blue) {
+		return 0, 0, 0, GetError()
+	}
+	return uint8(red), uint8(green), uint8(blue), nil
+}
+
+func (t *Texture) SetColorModFloat(red, green, blue float32) error {
+	if t == nil || t.handle == nil {
+		return fmt.Errorf("texture is destroyed")
+	}
+	if !C.SDL_SetTextureColorModFloat(t.handle, C.float(red), C.float(green), C.float(blue)) {
+		return GetError()
+	}
+	return nil
+}
+
+func (t *Texture) SetAlphaMod(alpha uint8) error {
+	if t == nil || t.handle == nil {
+		return fmt.Errorf("texture is destroyed")
+	}
+	if !C.SDL_SetTextureAlphaMod(t.handle, C.Uint8(alpha)) {
+		return GetError()
+	}
+	return nil
+}
+
+func (t *Texture) AlphaMod() (uint8, error) {
+	if t == nil || t.handle == nil {
+		return 0, fmt.Errorf("texture is destroyed")
+	}
+	var alpha C.Uint8
+	if !C.SDL_GetTextureAlphaMod(t.handle, &alpha) {
+		return 0, GetError()
+	}
+	return uint8(alpha), nil
+}
+
+func (t *Texture) SetAlphaModFloat(alpha float32) error {
+	if t == nil || t.handle == nil {
+		return fmt.Errorf("texture is destroyed")
+	}
+	if !C.SDL_SetTextureAlphaModFloat(t.handle, C.float(alpha)) {
+		return GetError()
+	}
+	return nil
+}
+
+func (t *Texture) SetBlendMode(mode BlendMode) error {
+	if t == nil || t.handle == nil {
+		return fmt.Errorf("texture is destroyed")
+	}
+	if !C.SDL_SetTextureBlendMode(t.handle, C.SDL_BlendMode(mode)) {
+		return GetError()
+	}
+	return nil
+}
+
+func (t *Texture) BlendMode() (BlendMode, error) {
+	if t == nil || t.handle == nil {
+		return BLENDMODE_INVALID, fmt.Errorf("texture is destroyed")
+	}
+	var mode C.SDL_BlendMode
+	if !C.SDL_GetTextureBlendMode(t.handle, &mode) {
+		return BLENDMODE_INVALID, GetError()
+	}
+	return BlendMode(mode), nil
+}
+
+func (t *Texture) SetScaleMode(mode ScaleMode) error {
+	if t == nil || t.handle == nil {
+		return fmt.Errorf("texture is destroyed")
+	}
+	if !C.SDL_SetTextureScaleMode(t.handle, C.SDL_ScaleMode(mode)) {
+		return GetError()
+	}
+	return nil
+}
+
+func (t *Texture) ScaleMode() (ScaleMode, error) {
+	if t == nil || t.handle == nil {
+		return SCALEMODE_LINEAR, fmt.Errorf("texture is destroyed")
+	}
+	var mode C.SDL_ScaleMode
+	if !C.SDL_GetTextureScaleMode(t.handle, &mode) {
+		return SCALEMODE_LINEAR, GetError()
+	}
+	return ScaleMode(mode), nil
+}
+
+// SetDrawBlendMode sets the blend mode used by the primitive drawing calls.
+func (r *Renderer) SetDrawBlendMode(mode BlendMode) error {
+	if r == nil || r.handle == nil {
+		return fmt.Errorf("renderer is destroyed")
+	}
+	if !C.SDL_SetRenderDrawBlendMode(r.handle, C.SDL_BlendMode(mode)) {
+		return GetError()
+	}
+	return nil
+}
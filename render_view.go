@@ -0,0 +1,232 @@
+package sdl3go
+
+/*
+#include <SDL3/SDL.h>
+*/
+import "C"
+
+import "fmt"
+
+// RendererLogicalPresentation selects how a fixed logical resolution is fitted
+// into the output.
+type RendererLogicalPresentation int
+
+const (
+	LOGICAL_PRESENTATION_DISABLED      RendererLogicalPresentation = C.SDL_LOGICAL_PRESENTATION_DISABLED
+	LOGICAL_PRESENTATION_STRETCH       RendererLogicalPresentation = C.SDL_LOGICAL_PRESENTATION_STRETCH
+	LOGICAL_PRESENTATION_LETTERBOX     RendererLogicalPresentation = C.SDL_LOGICAL_PRESENTATION_LETTERBOX
+	LOGICAL_PRESENTATION_OVERSCAN      RendererLogicalPresentation = C.SDL_LOGICAL_PRESENTATION_OVERSCAN
+	LOGICAL_PRESENTATION_INTEGER_SCALE RendererLogicalPresentation = C.SDL_LOGICAL_PRESENTATION_INTEGER_SCALE
+)
+
+// SetRenderTarget redirects drawing into texture, which must have been
+// created with TEXTUREACCESS_TARGET. Pass nil to draw to the window again.
+func (r *Renderer) SetRenderTarget(texture *Texture) error {
+	if r == nil || r.handle == nil {
+		return fmt.Errorf("renderer is destroyed")
+	}
+	if !C.SDL_SetRenderTarget(r.handle, texture.chandle()) {
+		return GetError()
+	}
+	return nil
+}
+
+// RenderTarget returns the current target texture, or nil for the window.
+// The result is a new wrapper around the same SDL texture, so destroy the
+// texture through the value you created, not this one.
+func (r *Renderer) RenderTarget() *Texture {
+	if r == nil || r.handle == nil {
+		return nil
+	}
+	handle := C.SDL_GetRenderTarget(r.handle)
+	if handle == nil {
+		return nil
+	}
+	return &Texture{handle: handle}
+}
+
+// SetRenderViewport restricts drawing to rect of the current target, in
+// logical coordinates. Pass nil to use the whole target.
+func (r *Renderer) SetRenderViewport(rect *Rect) error {
+	if r == nil || r.handle == nil {
+		return fmt.Errorf("renderer is destroyed")
+	}
+	if !C.SDL_SetRenderViewport(r.handle, rect.cptr()) {
+		return GetError()
+	}
+	return nil
+}
+
+func (r *Renderer) RenderViewport() (Rect, error) {
+	var rect Rect
+	if r == nil || r.handle == nil {
+		return rect, fmt.Errorf("renderer is destroyed")
+	}
+	if !C.SDL_GetRenderViewport(r.handle, rect.cptr()) {
+		return rect, GetError()
+	}
+	return rect, nil
+}
+
+// SetRenderClipRect clips drawing to rect, relative to the viewport. Pass nil
+// to disable clipping.
+func (r *Renderer) SetRenderClipRect(rect *Rect) error {
+	if r == nil || r.handle == nil {
+		return fmt.Errorf("renderer is destroyed")
+	}
+	if !C.SDL_SetRenderClipRect(r.handle, rect.cptr()) {
+		return GetError()
+	}
+	return nil
+}
+
+// RenderClipRect returns the clip rectangle and whether clipping is enabled.
+func (r *Renderer) RenderClipRect() (Rect, bool, error) {
+	var rect Rect
+	if r == nil || r.handle == nil {
+		return rect, false, fmt.Errorf("renderer is destroyed")
+	}
+	if !C.SDL_GetRenderClipRect(r.handle, rect.cptr()) {
+		return rect, false, GetError()
+	}
+	return rect, bool(C.SDL_RenderClipEnabled(r.handle)), nil
+}
+
+// SetRenderScale scales all drawing by scaleX and scaleY on top of the
+// logical presentation.
+func (r *Renderer) SetRenderScale(scaleX, scaleY float32) error {
+	if r == nil || r.handle == nil {
+		return fmt.Errorf("renderer is destroyed")
+	}
+	if !C.SDL_SetRenderScale(r.handle, C.float(scaleX), C.float(scaleY)) {
+		return GetError()
+	}
+	return nil
+}
+
+func (r *Renderer) RenderScale() (float32, float32, error) {
+	if r == nil || r.handle == nil {
+		return 0, 0, fmt.Errorf("renderer is destroyed")
+	}
+	var scaleX, scaleY C.float
+	if !C.SDL_GetRenderScale(r.handle, &scaleX, &scaleY) {
+		return 0, 0, GetError()
+	}
+	return float32(scaleX), float32(scaleY), nil
+}
+
+// SetRenderLogicalPresentation renders at width x height regardless of the
+// output size and fits the result using mode. Use
+// LOGICAL_PRESENTATION_DISABLED to draw in output pixels again.
+func (r *Renderer) SetRenderLogicalPresentation(width, height int, mode RendererLogicalPresentation) error {
+	if r == nil || r.handle == nil {
+		return fmt.Errorf("renderer is destroyed")
+	}
+	if !C.SDL_SetRenderLogicalPresentation(r.handle, C.int(width), C.int(height), C.SDL_RendererLogicalPresentation(mode)) {
+		return GetError()
+	}
+	return nil
+}
+
+func (r *Renderer) RenderLogicalPresentation() (int, int, RendererLogicalPresentation, error) {
+	if r == nil || r.handle == nil {
+		return 0, 0, LOGICAL_PRESENTATION_DISABLED, fmt.Errorf("renderer is destroyed")
+	}
+	var width, height C.int
+	var mode C.SDL_RendererLogicalPresentation
+	if !C.SDL_GetRenderLogicalPresentation(r.handle, &width, &height, &mode) {
+		return 0, 0, LOGICAL_PRESENTATION_DISABLED, GetError()
+	}
+	return int(width), int(height), RendererLogicalPresentation(mode), nil
+}
+
+// RenderCoordinatesFromWindow converts a point in window coordinates, such as
+// a mouse position, to render coordinates.
+func (r *Renderer) RenderCoordinatesFromWindow(windowX, windowY float32) (float32, float32, error) {
+	if r == nil || r.handle == nil {
+		return 0, 0, fmt.Errorf("renderer is destroyed")
+	}
+	var x, y C.float
+	if !C.SDL_RenderCoordinatesFromWindow(r.handle, C.float(windowX), C.float(windowY), &x, &y) {
+		return 0, 0, GetError()
+	}
+	return float32(x), float32(y), nil
+}
+
+// RenderCoordinatesToWindow converts a point in render coordinates to window
+// coordinates.
+func (r *Renderer) RenderCoordinatesToWindow(x, y float32) (float32, float32, error) {
+	if r == nil || r.handle == nil {
+		return 0, 0, fmt.Errorf("renderer is destroyed")
+	}
+	var windowX, windowY C.float
+	if !C.SDL_RenderCoordinatesToWindow(r.handle, C.float(x), C.float(y), &windowX, &windowY) {
+		return 0, 0, GetError()
+	}
+	return float32(windowX), float32(windowY), nil
+}
+
+// ConvertEventToRenderCoordinates rewrites the window coordinates in mouse,
+// pen and drop events to render coordinates, in place. Relative mouse motion
+// is scaled as well. Events for other windows, drop events without a
+// position and other event types are left untouched.
+func (r *Renderer) ConvertEventToRenderCoordinates(event *Event) error {
+	if r == nil || r.handle == nil {
+		return fmt.Errorf("renderer is destroyed")
+	}
+	if event == nil {
+		return nil
+	}
+	// A renderer without a window, such as a software renderer, matches
+	// events with no window, as in SDL.
+	var renderWindow WindowID
+	if window := C.SDL_GetRenderWindow(r.handle); window != nil {
+		renderWindow = WindowID(C.SDL_GetWindowID(window))
+	}
+	convert := func(window WindowID, x, y *float32) error {
+		if window != renderWindow {
+			return nil
+		}
+		cx, cy, err := r.RenderCoordinatesFromWindow(*x, *y)
+		if err != nil {
+			return err
+		}
+		*x, *y = cx, cy
+		return nil
+	}
+	switch {
+	case event.MouseMotion != nil:
+		motion := event.MouseMotion
+		if motion.WindowID != renderWindow {
+			return nil
+		}
+		// Relative motion is a vector, so convert both ends and take the
+		// difference rather than converting it as a point.
+		prevX, prevY := motion.X-motion.XRel, motion.Y-motion.YRel
+		if err := convert(motion.WindowID, &prevX, &prevY); err != nil {
+			return err
+		}
+		if err := convert(motion.WindowID, &motion.X, &motion.Y); err != nil {
+			return err
+		}
+		motion.XRel, motion.YRel = motion.X-prevX, motion.Y-prevY
+	case event.MouseButton != nil:
+		return convert(event.MouseButton.WindowID, &event.MouseButton.X, &event.MouseButton.Y)
+	case event.MouseWheel != nil:
+		return convert(event.MouseWheel.WindowID, &event.MouseWheel.MouseX, &event.MouseWheel.MouseY)
+	case event.PenMotion != nil:
+		return convert(event.PenMotion.WindowID, &event.PenMotion.X, &event.PenMotion.Y)
+	case event.PenTouch != nil:
+		return convert(event.PenTouch.WindowID, &event.PenTouch.X, &event.PenTouch.Y)
+	case event.PenButton != nil:
+		return convert(event.PenButton.WindowID, &event.PenButton.X, &event.PenButton.Y)
+	case event.PenAxis != nil:
+		return convert(event.PenAxis.WindowID, &event.PenAxis.X, &event.PenAxis.Y)
+	case event.Drop != nil:
+		switch event.Drop.Type {
+		case EVENT_DROP_FILE, EVENT_DROP_TEXT, EVENT_DROP_POSITION:
+			return convert(event.Drop.WindowID, &event.Drop.X, &event.Drop.Y)
+		}
+	}
+	return nil
+}
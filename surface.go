@@ -0,0 +1,224 @@
+package sdl3go
+
+/*
+#include <SDL3/SDL.h>
+*/
+import "C"
+
+import (
+	"fmt"
+	"image"
+	"runtime"
+	"unsafe"
+)
+
+type Surface struct {
+	handle *C.SDL_Surface
+
+	// Set by CreateSurfaceFrom: the Go memory SDL points into, pinned until
+	// Destroy so the garbage collector neither moves nor frees it.
+	pixels []byte
+	pinner *runtime.Pinner
+}
+
+func CreateSurface(width, height int, format PixelFormat) (*Surface, error) {
+	if width <= 0 || height <= 0 {
+		return nil, fmt.Errorf("invalid surface size %dx%d", width, height)
+	}
+	handle := C.SDL_CreateSurface(C.int(width), C.int(height), C.SDL_PixelFormat(format))
+	if handle == nil {
+		return nil, GetError()
+	}
+	return &Surface{handle: handle}, nil
+}
+
+// CreateSurfaceFrom wraps pixels, rows of format spaced pitch bytes apart,
+// without copying. The slice is pinned until Destroy, and writes through
+// either the slice or SDL are visible to both.
+func CreateSurfaceFrom(width, height int, format PixelFormat, pixels []byte, pitch int) (*Surface, error) {
+	if width <= 0 || height <= 0 {
+		return nil, fmt.Errorf("invalid surface size %dx%d", width, height)
+	}
+	need, err := pixelBufferSize(format, width, height, pitch)
+	if err != nil {
+		return nil, err
+	}
+	if len(pixels) < need {
+		return nil, fmt.Errorf("pixel buffer holds %d bytes, need %d", len(pixels), need)
+	}
+	pinner := new(runtime.Pinner)
+	pinner.Pin(&pixels[0])
+	handle := C.SDL_CreateSurfaceFrom(C.int(width), C.int(height), C.SDL_PixelFormat(format), unsafe.Pointer(&pixels[0]), C.int(pitch))
+	if handle == nil {
+		pinner.Unpin()
+		return nil, GetError()
+	}
+	return &Surface{handle: handle, pixels: pixels, pinner: pinner}, nil
+}
+
+// SurfaceFromImage copies img into a new RGBA32 surface with straight alpha.
+// *image.RGBA and *image.NRGBA are copied row by row; the other image types
+// decoders return, such as YCbCr, Gray and Paletted, are converted in bulk
+// without per-pixel color conversion.
+func SurfaceFromImage(img image.Image) (*Surface, error) {
+	bounds := img.Bounds()
+	surface, err := CreateSurface(bounds.Dx(), bounds.Dy(), PIXELFORMAT_RGBA32)
+	if err != nil {
+		return nil, err
+	}
+	pix, stride, premultiplied := imagePixels(img)
+	dst := surface.Pixels()
+	pitch := surface.Pitch()
+	for y := 0; y < bounds.Dy(); y++ {
+		row := dst[y*pitch : y*pitch+bounds.Dx()*4]
+		copy(row, pix[y*stride:])
+		if premultiplied {
+			unpremultiplyRow(row)
+		}
+	}
+	return surface, nil
+}
+
+// unpremultiplyRow converts RGBA32 pixels from premultiplied to straight
+// alpha in place.
+func unpremultiplyRow(row []byte) {
+	for i := 0; i+3 < len(row); i += 4 {
+		a := uint32(row[i+3])
+		if a == 0 || a == 0xff {
+			continue
+		}
+		row[i] = uint8(uint32(row[i]) * 0xff / a)
+		row[i+1] = uint8(uint32(row[i+1]) * 0xff / a)
+		row[i+2] = uint8(uint32(row[i+2]) * 0xff / a)
+	}
+}
+
+// ToImage copies the surface into a new *image.NRGBA, converting from the
+// surface's pixel format if it is not RGBA32.
+func (s *Surface) ToImage() (image.Image, error) {
+	if s == nil || s.handle == nil {
+		return nil, fmt.Errorf("surface is destroyed")
+	}
+	source := s.handle
+	if source.format != C.SDL_PIXELFORMAT_RGBA32 {
+		source = C.SDL_ConvertSurface(s.handle, C.SDL_PIXELFORMAT_RGBA32)
+		if source == nil {
+			return nil, GetError()
+		}
+		defer C.SDL_DestroySurface(source)
+	}
+	img := image.NewNRGBA(image.Rect(0, 0, int(source.w), int(source.h)))
+	if err := copySurfacePixels(source, img.Pix, img.Stride); err != nil {
+		return nil, err
+	}
+	return img, nil
+}
+
+// copySurfacePixels copies the rows of a 32-bit surface into dst, stride
+// bytes apart.
+func copySurfacePixels(surface *C.SDL_Surface, dst []byte, stride int) error {
+	if !C.SDL_LockSurface(surface) {
+		return GetError()
+	}
+	defer C.SDL_UnlockSurface(surface)
+
+	width, height, pitch := int(surface.w), int(surface.h), int(surface.pitch)
+	if width == 0 || height == 0 {
+		return nil
+	}
+	src := unsafe.Slice((*byte)(surface.pixels), (height-1)*pitch+width*4)
+	for y := 0; y < height; y++ {
+		copy(dst[y*stride:y*stride+width*4], src[y*pitch:])
+	}
+	return nil
+}
+
+func (s *Surface) Width() int {
+	if s == nil || s.handle == nil {
+		return 0
+	}
+	return int(s.handle.w)
+}
+
+func (s *Surface) Height() int {
+	if s == nil || s.handle == nil {
+		return 0
+	}
+	return int(s.handle.h)
+}
+
+// Pitch is the distance in bytes between the starts of two rows.
+func (s *Surface) Pitch() int {
+	if s == nil || s.handle == nil {
+		return 0
+	}
+	return int(s.handle.pitch)
+}
+
+func (s *Surface) Format() PixelFormat {
+	if s == nil || s.handle == nil {
+		return PIXELFORMAT_UNKNOWN
+	}
+	return PixelFormat(s.handle.format)
+}
+
+// Pixels returns the surface memory as rows of Pitch bytes. It aliases the
+// surface and is invalid after Destroy. RLE-accelerated surfaces must be
+// locked with Lock before their pixels can be accessed.
+func (s *Surface) Pixels() []byte {
+	if s == nil || s.handle == nil || s.handle.pixels == nil {
+		return nil
+	}
+	size, err := pixelBufferSize(s.Format(), s.Width(), s.Height(), s.Pitch())
+	if err != nil {
+		return nil
+	}
+	return unsafe.Slice((*byte)(s.handle.pixels), size)
+}
+
+func (s *Surface) Lock() error {
+	if s == nil || s.handle == nil {
+		return fmt.Errorf("surface is destroyed")
+	}
+	if !C.SDL_LockSurface(s.handle) {
+		return GetError()
+	}
+	return nil
+}
+
+func (s *Surface) Unlock() {
+	if s == nil || s.handle == nil {
+		return
+	}
+	C.SDL_UnlockSurface(s.handle)
+}
+
+func (s *Surface) Destroy() {
+	if s == nil || s.handle == nil {
+		return
+	}
+	C.SDL_DestroySurface(s.handle)
+	s.handle = nil
+	if s.pinner != nil {
+		s.pinner.Unpin()
+		s.pinner = nil
+		s.pixels = nil
+	}
+}
+
+// ScaleMode selects the filter used when scaling textures and surfaces.
+type ScaleMode int
+
+const (
+	SCALEMODE_NEAREST ScaleMode = C.SDL_SCALEMODE_NEAREST
+	SCALEMODE_LINEAR  ScaleMode = C.SDL_SCALEMODE_LINEAR
+)
+
+// FlipMode mirrors an image horizontally, vertically, or both (FLIP_HORIZONTAL|FLIP_VERTICAL).
+type FlipMode int
+
+const (
+	FLIP_NONE       FlipMode = C.SDL_FLIP_NONE
+	FLIP_HORIZONTAL FlipMode = C.SDL_FLIP_HORIZONTAL
+	FLIP_VERTICAL   FlipMode = C.SDL_FLIP_VERTICAL
+)
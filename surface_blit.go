@@ -0,0 +1,204 @@
+package sdl3go
+
+/*
+#include <SDL3/SDL.h>
+*/
+import "C"
+
+import "fmt"
+
+// In the blits below a nil srcRect copies the whole source and a nil dstRect
+// targets the whole destination. BlitSurface only uses the position of
+// dstRect; the scaled blits stretch to its size.
+
+func BlitSurface(src *Surface, srcRect *Rect, dst *Surface, dstRect *Rect) error {
+	if src == nil || src.handle == nil || dst == nil || dst.handle == nil {
+		return fmt.Errorf("surface is destroyed")
+	}
+	if !C.SDL_BlitSurface(src.handle, srcRect.cptr(), dst.handle, dstRect.cptr()) {
+		return GetError()
+	}
+	return nil
+}
+
+func BlitSurfaceScaled(src *Surface, srcRect *Rect, dst *Surface, dstRect *Rect, mode ScaleMode) error {
+	if src == nil || src.handle == nil || dst == nil || dst.handle == nil {
+		return fmt.Errorf("surface is destroyed")
+	}
+	if !C.SDL_BlitSurfaceScaled(src.handle, srcRect.cptr(), dst.handle, dstRect.cptr(), C.SDL_ScaleMode(mode)) {
+		return GetError()
+	}
+	return nil
+}
+
+// BlitSurface9Grid draws srcRect as a 9-slice into dstRect. The corners keep
+// their size times scale, the edges stretch along one axis and the center
+// along both, using mode for the stretched parts.
+func BlitSurface9Grid(src *Surface, srcRect *Rect, leftWidth, rightWidth, topHeight, bottomHeight int, scale float32, mode ScaleMode, dst *Surface, dstRect *Rect) error {
+	if src == nil || src.handle == nil || dst == nil || dst.handle == nil {
+		return fmt.Errorf("surface is destroyed")
+	}
+	if !C.SDL_BlitSurface9Grid(src.handle, srcRect.cptr(), C.int(leftWidth), C.int(rightWidth), C.int(topHeight), C.int(bottomHeight),
+		C.float(scale), C.SDL_ScaleMode(mode), dst.handle, dstRect.cptr()) {
+		return GetError()
+	}
+	return nil
+}
+
+// FillSurfaceRect sets every pixel in rect, or the whole surface if rect is
+// nil, to color, a pixel value in the surface's format (see
+// Surface.MapRGBA). It ignores the blend mode.
+func FillSurfaceRect(dst *Surface, rect *Rect, color uint32) error {
+	if dst == nil || dst.handle == nil {
+		return fmt.Errorf("surface is destroyed")
+	}
+	if !C.SDL_FillSurfaceRect(dst.handle, rect.cptr(), C.Uint32(color)) {
+		return GetError()
+	}
+	return nil
+}
+
+func FillSurfaceRects(dst *Surface, rects []Rect, color uint32) error {
+	if dst == nil || dst.handle == nil {
+		return fmt.Errorf("surface is destroyed")
+	}
+	if len(rects) == 0 {
+		return nil
+	}
+	if !C.SDL_FillSurfaceRects(dst.handle, rects[0].cptr(), C.int(len(rects)), C.Uint32(color)) {
+		return GetError()
+	}
+	return nil
+}
+
+// ConvertSurface returns a copy of surface in format. The original is left
+// untouched.
+func ConvertSurface(surface *Surface, format PixelFormat) (*Surface, error) {
+	if surface == nil || surface.handle == nil {
+		return nil, fmt.Errorf("surface is destroyed")
+	}
+	handle := C.SDL_ConvertSurface(surface.handle, C.SDL_PixelFormat(format))
+	if handle == nil {
+		return nil, GetError()
+	}
+	return &Surface{handle: handle}, nil
+}
+
+// FlipSurface mirrors surface in place.
+func FlipSurface(surface *Surface, flip FlipMode) error {
+	if surface == nil || surface.handle == nil {
+		return fmt.Errorf("surface is destroyed")
+	}
+	if !C.SDL_FlipSurface(surface.handle, C.SDL_FlipMode(flip)) {
+		return GetError()
+	}
+	return nil
+}
+
+// ScaleSurface returns a copy of surface resized to width x height.
+func ScaleSurface(surface *Surface, width, height int, mode ScaleMode) (*Surface, error) {
+	if surface == nil || surface.handle == nil {
+		return nil, fmt.Errorf("surface is destroyed")
+	}
+	handle := C.SDL_ScaleSurface(surface.handle, C.int(width), C.int(height), C.SDL_ScaleMode(mode))
+	if handle == nil {
+		return nil, GetError()
+	}
+	return &Surface{handle: handle}, nil
+}
+
+// SetColorKey makes pixels equal to key, a pixel value in the surface's
+// format, transparent when blitting. Pass enabled false to turn it off.
+func (s *Surface) SetColorKey(enabled bool, key uint32) error {
+	if s == nil || s.handle == nil {
+		return fmt.Errorf("surface is destroyed")
+	}
+	if !C.SDL_SetSurfaceColorKey(s.handle, C.bool(enabled), C.Uint32(key)) {
+		return GetError()
+	}
+	return nil
+}
+
+// ColorKey returns the color key and whether one is set.
+func (s *Surface) ColorKey() (uint32, bool) {
+	if s == nil || s.handle == nil || !C.SDL_SurfaceHasColorKey(s.handle) {
+		return 0, false
+	}
+	var key C.Uint32
+	if !C.SDL_GetSurfaceColorKey(s.handle, &key) {
+		return 0, false
+	}
+	return uint32(key), true
+}
+
+func (s *Surface) SetColorMod(red, green, blue uint8) error {
+	if s == nil || s.handle == nil {
+		return fmt.Errorf("surface is destroyed")
+	}
+	if !C.SDL_SetSurfaceColorMod(s.handle, C.Uint8(red), C.Uint8(green), C.Uint8(blue)) {
+		return GetError()
+	}
+	return nil
+}
+
+func (s *Surface) ColorMod() (uint8, uint8, uint8, error) {
+	if s == nil || s.handle == nil {
+		return 0, 0, 0, fmt.Errorf("surface is destroyed")
+	}
+	var red, green, blue C.Uint8
+	if !C.SDL_GetSurfaceColorMod(s.handle, &red, &green, &blue) {
+		return 0, 0, 0, GetError()
+	}
+	return uint8(red), uint8(green), uint8(blue), nil
+}
+
+func (s *Surface) SetAlphaMod(alpha uint8) error {
+	if s == nil || s.handle == nil {
+		return fmt.Errorf("surface is destroyed")
+	}
+	if !C.SDL_SetSurfaceAlphaMod(s.handle, C.Uint8(alpha)) {
+		return GetError()
+	}
+	return nil
+}
+
+func (s *Surface) AlphaMod() (uint8, error) {
+	if s == nil || s.handle == nil {
+		return 0, fmt.Errorf("surface is destroyed")
+	}
+	var alpha C.Uint8
+	if !C.SDL_GetSurfaceAlphaMod(s.handle, &alpha) {
+		return 0, GetError()
+	}
+	return uint8(alpha), nil
+}
+
+func (s *Surface) SetBlendMode(mode BlendMode) error {
+	if s == nil || s.handle == nil {
+		return fmt.Errorf("surface is destroyed")
+	}
+	if !C.SDL_SetSurfaceBlendMode(s.handle, C.SDL_BlendMode(mode)) {
+		return GetError()
+	}
+	return nil
+}
+
+func (s *Surface) BlendMode() (BlendMode, error) {
+	if s == nil || s.handle == nil {
+		return BLENDMODE_INVALID, fmt.Errorf("surface is destroyed")
+	}
+	var mode C.SDL_BlendMode
+	if !C.SDL_GetSurfaceBlendMode(s.handle, &mode) {
+		return BLENDMODE_INVALID, GetError()
+	}
+	return BlendMode(mode), nil
+}
+
+// SetClipRect limits blits into the surface to rect. Pass nil to clip to the
+// whole surface. It reports false if rect does not intersect the surface.
+func (s *Surface) SetClipRect(rect *Rect) bool {
+	if s == nil || s.handle == nil {
+		return false
+	}
+	return bool(C.SDL_SetSurfaceClipRect(s.handle, rect.cptr()))
+}
@@ -13,14 +13,83 @@ static float sdl3go_window_sdr_white_level(SDL_PropertiesID props) {
 static float sdl3go_window_hdr_headroom(SDL_PropertiesID props) {
     return SDL_GetFloatProperty(props, SDL_PROP_WINDOW_HDR_HEADROOM_FLOAT, 1.0f);
 }
+
+static bool sdl3go_display_hdr_enabled(SDL_PropertiesID props) {
+    return SDL_GetBooleanProperty(props, SDL_PROP_DISPLAY_HDR_ENABLED_BOOLEAN, false);
+}
+
+static void *sdl3go_window_x11_display(SDL_PropertiesID props) {
+    return SDL_GetPointerProperty(props, SDL_PROP_WINDOW_X11_DISPLAY_POINTER, NULL);
+}
+static Sint64 sdl3go_window_x11_screen(SDL_PropertiesID props) {
+    return SDL_GetNumberProperty(props, SDL_PROP_WINDOW_X11_SCREEN_NUMBER, 0);
+}
+static Sint64 sdl3go_window_x11_window(SDL_PropertiesID props) {
+    return SDL_GetNumberProperty(props, SDL_PROP_WINDOW_X11_WINDOW_NUMBER, 0);
+}
+static void *sdl3go_window_wayland_display(SDL_PropertiesID props) {
+    return SDL_GetPointerProperty(props, SDL_PROP_WINDOW_WAYLAND_DISPLAY_POINTER, NULL);
+}
+static void *sdl3go_window_wayland_surface(SDL_PropertiesID props) {
+    return SDL_GetPointerProperty(props, SDL_PROP_WINDOW_WAYLAND_SURFACE_POINTER, NULL);
+}
+static void *sdl3go_window_win32_hwnd(SDL_PropertiesID props) {
+    return SDL_GetPointerProperty(props, SDL_PROP_WINDOW_WIN32_HWND_POINTER, NULL);
+}
+static void *sdl3go_window_win32_hdc(SDL_PropertiesID props) {
+    return SDL_GetPointerProperty(props, SDL_PROP_WINDOW_WIN32_HDC_POINTER, NULL);
+}
+static void *sdl3go_window_win32_instance(SDL_PropertiesID props) {
+    return SDL_GetPointerProperty(props, SDL_PROP_WINDOW_WIN32_INSTANCE_POINTER, NULL);
+}
+static void *sdl3go_window_cocoa_window(SDL_PropertiesID props) {
+    return SDL_GetPointerProperty(props, SDL_PROP_WINDOW_COCOA_WINDOW_POINTER, NULL);
+}
+
+// Wraps an existing native window. SDL marks the result SDL_WINDOW_EXTERNAL
+// and will not destroy the native window when the SDL window is destroyed.
+static SDL_Window *sdl3go_create_external_window(const char *title, SDL_WindowFlags flags,
+        void *win32_hwnd, Sint64 x11_window, void *cocoa_window, void *wayland_surface) {
+    SDL_PropertiesID props = SDL_CreateProperties();
+    if (props == 0) {
+        return NULL;
+    }
+    SDL_SetStringProperty(props, SDL_PROP_WINDOW_CREATE_TITLE_STRING, title);
+    SDL_SetNumberProperty(props, SDL_PROP_WINDOW_CREATE_FLAGS_NUMBER, (Sint64)flags);
+    if (win32_hwnd != NULL) {
+        SDL_SetPointerProperty(props, SDL_PROP_WINDOW_CREATE_WIN32_HWND_POINTER, win32_hwnd);
+    }
+    if (x11_window != 0) {
+        SDL_SetNumberProperty(props, SDL_PROP_WINDOW_CREATE_X11_WINDOW_NUMBER, x11_window);
+    }
+    if (cocoa_window != NULL) {
+        SDL_SetPointerProperty(props, SDL_PROP_WINDOW_CREATE_COCOA_WINDOW_POINTER, cocoa_window);
+    }
+    if (wayland_surface != NULL) {
+        SDL_SetPointerProperty(props, SDL_PROP_WINDOW_CREATE_WAYLAND_WL_SURFACE_POINTER, wayland_surface);
+    }
+    SDL_Window *window = SDL_CreateWindowWithProperties(props);
+    SDL_DestroyProperties(props);
+    return window;
+}
 */
 import "C"
-import "unsafe"
+import (
+	"fmt"
+	"unsafe"
+)
+
+type DisplayID uint32
 
 type WindowHDRState struct {
 	Enabled       bool
 	SDRWhiteLevel float32
 	Headroom      float32
+
+	// Display is the display the window is mostly on, or 0 if unknown.
+	// DisplayHDREnabled reports whether that display is currently in HDR mode.
+	Display           DisplayID
+	DisplayHDREnabled bool
 }
 
 // HDRState reports SDL's current desktop compositor state for this window.
@@ -31,11 +100,102 @@ func (w *Window) HDRState() WindowHDRState {
 		return WindowHDRState{SDRWhiteLevel: 1, Headroom: 1}
 	}
 	properties := C.SDL_GetWindowProperties(w.handle)
-	return WindowHDRState{
+	state := WindowHDRState{
 		Enabled:       bool(C.sdl3go_window_hdr_enabled(properties)),
 		SDRWhiteLevel: float32(C.sdl3go_window_sdr_white_level(properties)),
 		Headroom:      float32(C.sdl3go_window_hdr_headroom(properties)),
 	}
+	if display := C.SDL_GetDisplayForWindow(w.handle); display != 0 {
+		state.Display = DisplayID(display)
+		state.DisplayHDREnabled = bool(C.sdl3go_display_hdr_enabled(C.SDL_GetDisplayProperties(display)))
+	}
+	return state
+}
+
+// ICCProfile returns the raw ICC profile of the display the window is on, or
+// an error if the platform does not expose one. Listen for
+// EVENT_WINDOW_ICCPROF_CHANGED to know when to read it again.
+func (w *Window) ICCProfile() ([]byte, error) {
+	if w == nil || w.handle == nil {
+		return nil, fmt.Errorf("window is destroyed")
+	}
+	var size C.size_t
+	data := C.SDL_GetWindowICCProfile(w.handle, &size)
+	if data == nil {
+		return nil, GetError()
+	}
+	defer C.SDL_free(data)
+	return C.GoBytes(data, C.int(size)), nil
+}
+
+// PixelFormat returns the format of the window's drawable surface.
+func (w *Window) PixelFormat() PixelFormat {
+	if w == nil || w.handle == nil {
+		return PIXELFORMAT_UNKNOWN
+	}
+	return PixelFormat(C.SDL_GetWindowPixelFormat(w.handle))
+}
+
+// WindowNativeHandles holds the platform handles behind an SDL window. Only the
+// fields for the active video driver are set; the rest are left zero. The
+// pointers are owned by SDL and stay valid until the window is destroyed.
+type WindowNativeHandles struct {
+	VideoDriver string // "x11", "wayland", "windows", "cocoa", ...
+
+	X11Display unsafe.Pointer // Display*
+	X11Screen  int
+	X11Window  uint64 // Window XID
+
+	WaylandDisplay unsafe.Pointer // struct wl_display*
+	WaylandSurface unsafe.Pointer // struct wl_surface*
+
+	Win32HWND     unsafe.Pointer
+	Win32HDC      unsafe.Pointer
+	Win32Instance unsafe.Pointer
+
+	CocoaWindow unsafe.Pointer // NSWindow*
+}
+
+// NativeHandles reads the native window handles from the window's properties.
+func (w *Window) NativeHandles() WindowNativeHandles {
+	var handles WindowNativeHandles
+	if w == nil || w.handle == nil {
+		return handles
+	}
+	if driver := C.SDL_GetCurrentVideoDriver(); driver != nil {
+		handles.VideoDriver = C.GoString(driver)
+	}
+	properties := C.SDL_GetWindowProperties(w.handle)
+	handles.X11Display = C.sdl3go_window_x11_display(properties)
+	handles.X11Screen = int(C.sdl3go_window_x11_screen(properties))
+	handles.X11Window = uint64(C.sdl3go_window_x11_window(properties))
+	handles.WaylandDisplay = C.sdl3go_window_wayland_display(properties)
+	handles.WaylandSurface = C.sdl3go_window_wayland_surface(properties)
+	handles.Win32HWND = C.sdl3go_window_win32_hwnd(properties)
+	handles.Win32HDC = C.sdl3go_window_win32_hdc(properties)
+	handles.Win32Instance = C.sdl3go_window_win32_instance(properties)
+	handles.CocoaWindow = C.sdl3go_window_cocoa_window(properties)
+	return handles
+}
+
+// CreateWindowFromNative wraps a window created by another toolkit. Set the
+// handle for the current platform (Win32HWND, X11Window, CocoaWindow or
+// WaylandSurface). The returned window has WINDOW_EXTERNAL set, and Destroy
+// releases only SDL's state, never the native window itself.
+func CreateWindowFromNative(title string, handles WindowNativeHandles, flags WindowFlags) (*Window, error) {
+	if handles.Win32HWND == nil && handles.X11Window == 0 && handles.CocoaWindow == nil && handles.WaylandSurface == nil {
+		return nil, fmt.Errorf("no native window handle given")
+	}
+	cTitle := C.CString(title)
+	defer C.free(unsafe.Pointer(cTitle))
+
+	window := C.sdl3go_create_external_window(cTitle, C.SDL_WindowFlags(flags),
+		handles.Win32HWND, C.Sint64(handles.X11Window), handles.CocoaWindow, handles.WaylandSurface)
+	if window == nil {
+		return nil, GetError()
+	}
+
+	return &Window{handle: window}, nil
 }
 
 func CreateWindow(title string, width, height int, flags WindowFlags) (*Window, error) {
@@ -54,6 +214,13 @@ func (w *Window) Destroy() {
 	C.SDL_DestroyWindow(w.handle)
 }
 
+func (w *Window) Flags() WindowFlags {
+	if w == nil || w.handle == nil {
+		return 0
+	}
+	return WindowFlags(C.SDL_GetWindowFlags(w.handle))
+}
+
 func (w *Window) GetSize() (int, int, error) {
 	var width C.int
 	var height C.int
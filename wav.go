@@ -0,0 +1,170 @@
+package sdl3go
+
+/*
+#include <stdlib.h>
+#include <SDL3/SDL.h>
+*/
+import "C"
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"unsafe"
+)
+
+// LoadWAV decodes a WAV file into its format and interleaved sample bytes.
+// The data is in spec.Format, ready for PutBytes on a stream opened with the
+// same spec.
+func LoadWAV(path string) (AudioSpec, []byte, error) {
+	cPath := C.CString(path)
+	defer C.free(unsafe.Pointer(cPath))
+
+	var spec C.SDL_AudioSpec
+	var buf *C.Uint8
+	var length C.Uint32
+	if !C.SDL_LoadWAV(cPath, &spec, &buf, &length) {
+		return AudioSpec{}, nil, GetError()
+	}
+	defer C.SDL_free(unsafe.Pointer(buf))
+	return audioSpecFromC(spec), C.GoBytes(unsafe.Pointer(buf), C.int(length)), nil
+}
+
+// LoadWAVFromReader is LoadWAV for a whole WAV file read from r.
+func LoadWAVFromReader(r io.Reader) (AudioSpec, []byte, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return AudioSpec{}, nil, err
+	}
+	stream, release, err := memoryReadStream(data)
+	if err != nil {
+		return AudioSpec{}, nil, err
+	}
+	defer release()
+
+	var spec C.SDL_AudioSpec
+	var buf *C.Uint8
+	var length C.Uint32
+	if !C.SDL_LoadWAV_IO(stream, true, &spec, &buf, &length) {
+		return AudioSpec{}, nil, GetError()
+	}
+	defer C.SDL_free(unsafe.Pointer(buf))
+	return audioSpecFromC(spec), C.GoBytes(unsafe.Pointer(buf), C.int(length)), nil
+}
+
+// SaveWAV writes samples to path as a WAV file. samples is either raw bytes
+// in spec.Format (as returned by LoadWAV or GetBytes) or a slice matching
+// spec.Format, such as the []float32 filled by GetFloat32 on a recording
+// stream opened with AUDIO_F32.
+func SaveWAV[T AudioSample](path string, spec AudioSpec, samples []T) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := WriteWAV(file, spec, samples); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// WriteWAV is SaveWAV to an io.Writer.
+func WriteWAV[T AudioSample](w io.Writer, spec AudioSpec, samples []T) error {
+	if !spec.valid() {
+		return fmt.Errorf("invalid audio format %v, %d Hz, %d channels", spec.Format, spec.Freq, spec.Channels)
+	}
+	var data []byte
+	if len(samples) > 0 {
+		var zero T
+		if _, raw := any(zero).(uint8); !raw {
+			if err := checkSampleType[T](spec.Format); err != nil {
+				return err
+			}
+		}
+		data = unsafe.Slice((*byte)(unsafe.Pointer(&samples[0])), len(samples)*int(unsafe.Sizeof(samples[0])))
+	}
+	if len(data)%spec.FrameSize() != 0 {
+		return fmt.Errorf("%d bytes is not a whole number of %d-byte frames", len(data), spec.FrameSize())
+	}
+	return writeWAV(w, spec, data)
+}
+
+const (
+	wavFormatPCM       = 1
+	wavFormatIEEEFloat = 3
+)
+
+func writeWAV(w io.Writer, spec AudioSpec, data []byte) error {
+	frameSize := spec.FrameSize()
+	frames := len(data) / frameSize
+	float := spec.Format.IsFloat()
+
+	formatTag, fmtSize := uint16(wavFormatPCM), uint32(16)
+	if float {
+		// Non-PCM formats carry a cbSize field and a fact chunk.
+		formatTag, fmtSize = wavFormatIEEEFloat, 18
+	}
+	pad := len(data) % 2
+	riffSize := 4 + (8 + fmtSize) + (8 + uint32(len(data)+pad))
+	if float {
+		riffSize += 8 + 4
+	}
+
+	out := bufio.NewWriter(w)
+	le := binary.LittleEndian
+	header := make([]byte, 0, 58)
+	header = append(header, "RIFF"...)
+	header = le.AppendUint32(header, riffSize)
+	header = append(header, "WAVEfmt "...)
+	header = le.AppendUint32(header, fmtSize)
+	header = le.AppendUint16(header, formatTag)
+	header = le.AppendUint16(header, uint16(spec.Channels))
+	header = le.AppendUint32(header, uint32(spec.Freq))
+	header = le.AppendUint32(header, uint32(spec.Freq*frameSize))
+	header = le.AppendUint16(header, uint16(frameSize))
+	header = le.AppendUint16(header, uint16(spec.Format.BitSize()))
+	if float {
+		header = le.AppendUint16(header, 0)
+		header = append(header, "fact"...)
+		header = le.AppendUint32(header, 4)
+		header = le.AppendUint32(header, uint32(frames))
+	}
+	header = append(header, "data"...)
+	header = le.AppendUint32(header, uint32(len(data)))
+	if _, err := out.Write(header); err != nil {
+		return err
+	}
+
+	// WAV stores multi-byte samples little-endian and 8-bit samples
+	// unsigned, so convert anything else while copying.
+	sampleSize := spec.Format.ByteSize()
+	swap := sampleSize > 1 && spec.Format.IsBigEndian()
+	flipSign := sampleSize == 1 && spec.Format.IsSigned()
+	if !swap && !flipSign {
+		if _, err := out.Write(data); err != nil {
+			return err
+		}
+	} else {
+		sample := make([]byte, sampleSize)
+		for i := 0; i < len(data); i += sampleSize {
+			for j := range sample {
+				if swap {
+					sample[j] = data[i+sampleSize-1-j]
+				} else {
+					sample[j] = data[i+j] ^ 0x80
+				}
+			}
+			if _, err := out.Write(sample); err != nil {
+				return err
+			}
+		}
+	}
+	if pad != 0 {
+		if err := out.WriteByte(0); err != nil {
+			return err
+		}
+	}
+	return out.Flush()
+}